@@ -34,14 +34,22 @@ func Commit(prvkey *ecdsa.PrivateKey, cipher, user string) string {
 
 // authorization check
 func CheckCommitment(cipher, user, commitment string) bool {
-	commData,_ := base64.RawStdEncoding.DecodeString(commitment)
+	commData, err := base64.RawStdEncoding.DecodeString(commitment)
+	if err != nil || len(commData) <= 65 {
+		return false
+	}
 	hash := sha256.Sum256([]byte(cipher+user))
 
 	x,y := elliptic.Unmarshal(curve, commData[:65])
-	pub := &ecdsa.PublicKey{curve, x, y}
+	if x == nil {
+		return false
+	}
+	pub := &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
 	sig := commData[65:]
 	sigRS := new(ECDSASignature)
-	asn1.Unmarshal(sig, sigRS)
+	if _, err := asn1.Unmarshal(sig, sigRS); err != nil {
+		return false
+	}
 
 	return ecdsa.Verify(pub, hash[:], sigRS.R, sigRS.S)
 }
@@ -0,0 +1,58 @@
+package pailliersdk
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+// Backend implements the paillier arithmetic and key serialization. Inputs
+// are validated before they reach a backend, so implementations may assume
+// well formed keys, ciphertexts in Z*_{n^2} and plaintexts in [0, n).
+type Backend interface {
+	// Name identifies the backend in RegisterBackend and LookupBackend
+	Name() string
+
+	KeyGen(bits int) (*PrivateKey, error)
+	Encrypt(pk *PublicKey, msg *big.Int) (Ciphertext, error)
+	Decrypt(sk *PrivateKey, cipher Ciphertext) (*big.Int, error)
+	Add(pk *PublicKey, cipher1, cipher2 Ciphertext) (Ciphertext, error)
+	MulScalar(pk *PublicKey, cipher Ciphertext, scalar *big.Int) (Ciphertext, error)
+
+	// keys are serialized in the hex format of libpaillier
+	MarshalPublicKey(pk *PublicKey) string
+	UnmarshalPublicKey(s string) (*PublicKey, error)
+	MarshalPrivateKey(sk *PrivateKey) string
+	UnmarshalPrivateKey(pk *PublicKey, s string) (*PrivateKey, error)
+}
+
+var (
+	backendsMu sync.RWMutex
+	backends   = make(map[string]Backend)
+)
+
+// RegisterBackend makes a backend available by its name, it panics if the
+// name is already taken.
+func RegisterBackend(b Backend) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+	if _, dup := backends[b.Name()]; dup {
+		panic(fmt.Sprintf("pailliersdk: backend %q registered twice", b.Name()))
+	}
+	backends[b.Name()] = b
+}
+
+// LookupBackend returns the backend registered under name.
+func LookupBackend(name string) (Backend, bool) {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+	b, ok := backends[name]
+	return b, ok
+}
+
+// DefaultBackend returns libpaillier when built with cgo, the pure go
+// backend otherwise.
+func DefaultBackend() Backend {
+	b, _ := LookupBackend(defaultBackendName)
+	return b
+}
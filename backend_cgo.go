@@ -0,0 +1,428 @@
+//go:build cgo && !purego
+// +build cgo,!purego
+
+package pailliersdk
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/include
+#cgo LDFLAGS: -L${SRCDIR}/lib -lpaillier -lgmp
+
+#include "paillier.h"
+#include "gmp.h"
+#include <string.h>
+#include <stdio.h>
+#include <stdlib.h>
+
+static paillier_pubkey_t* pubkey_from_bytes(int bits, void* n, int nlen, void* n2, int n2len) {
+	paillier_pubkey_t* pub = (paillier_pubkey_t*) malloc(sizeof(paillier_pubkey_t));
+	pub->bits = bits;
+	mpz_init(pub->n);
+	mpz_init(pub->n_squared);
+	mpz_init(pub->n_plusone);
+	mpz_import(pub->n, nlen, 1, 1, 0, 0, n);
+	mpz_import(pub->n_squared, n2len, 1, 1, 0, 0, n2);
+	mpz_add_ui(pub->n_plusone, pub->n, 1);
+	return pub;
+}
+
+static paillier_prvkey_t* prvkey_from_bytes(void* lambda, int lambdalen, void* x, int xlen) {
+	paillier_prvkey_t* prv = (paillier_prvkey_t*) malloc(sizeof(paillier_prvkey_t));
+	mpz_init(prv->lambda);
+	mpz_init(prv->x);
+	mpz_import(prv->lambda, lambdalen, 1, 1, 0, 0, lambda);
+	mpz_import(prv->x, xlen, 1, 1, 0, 0, x);
+	return prv;
+}
+
+static void* mpz_to_bytes(mpz_ptr z, int* len) {
+	size_t written = 0;
+	void* buf = mpz_export(0, &written, 1, 1, 0, 0, z);
+	*len = (int) written;
+	return buf;
+}
+
+// L_p(c^(p-1) mod p^2) * hp mod p
+static void dec_crt_part(mpz_t res, mpz_t c, mpz_t p, mpz_t hp) {
+	mpz_t p2, e;
+	mpz_init(p2);
+	mpz_init(e);
+	mpz_mul(p2, p, p);
+	mpz_sub_ui(e, p, 1);
+	mpz_mod(res, c, p2);
+	mpz_powm(res, res, e, p2);
+	mpz_sub_ui(res, res, 1);
+	mpz_divexact(res, res, p);
+	mpz_mul(res, res, hp);
+	mpz_mod(res, res, p);
+	mpz_clear(p2);
+	mpz_clear(e);
+}
+
+// m = mq + ((mp - mq) * q^-1 mod p) * q, the operands are big-endian bytes
+// in the order c, p, q, hp, hq, q^-1 mod p
+static void* dec_crt(void** in, int* inlen, int* len) {
+	mpz_t v[6], mp, mq;
+	int i;
+	void* buf;
+	for (i = 0; i < 6; i++) {
+		mpz_init(v[i]);
+		mpz_import(v[i], inlen[i], 1, 1, 0, 0, in[i]);
+	}
+	mpz_init(mp);
+	mpz_init(mq);
+	dec_crt_part(mp, v[0], v[1], v[3]);
+	dec_crt_part(mq, v[0], v[2], v[4]);
+	mpz_sub(mp, mp, mq);
+	mpz_mul(mp, mp, v[5]);
+	mpz_mod(mp, mp, v[1]);
+	mpz_mul(mp, mp, v[2]);
+	mpz_add(mp, mp, mq);
+	buf = mpz_to_bytes(mp, len);
+	for (i = 0; i < 6; i++) {
+		mpz_clear(v[i]);
+	}
+	mpz_clear(mp);
+	mpz_clear(mq);
+	return buf;
+}
+
+// prod bases[i]^exps[i] mod m with windows of w bits shared by all bases,
+// the operands are big-endian bytes
+static void* multi_exp(int k, void** bases, int* baselen, void** exps, int* explen,
+					   void* m, int mlen, int w, int* len) {
+	mpz_t *b = (mpz_t*) malloc(k * sizeof(mpz_t));
+	mpz_t *e = (mpz_t*) malloc(k * sizeof(mpz_t));
+	mpz_t *table = (mpz_t*) malloc((size_t) k * (1 << w) * sizeof(mpz_t));
+	mpz_t mod, acc;
+	size_t bits = 0;
+	int i, d, s;
+	long j, windows;
+	void* buf;
+
+	mpz_init(mod);
+	mpz_import(mod, mlen, 1, 1, 0, 0, m);
+	for (i = 0; i < k; i++) {
+		mpz_init(b[i]);
+		mpz_init(e[i]);
+		mpz_import(b[i], baselen[i], 1, 1, 0, 0, bases[i]);
+		mpz_import(e[i], explen[i], 1, 1, 0, 0, exps[i]);
+		if (mpz_sizeinbase(e[i], 2) > bits) {
+			bits = mpz_sizeinbase(e[i], 2);
+		}
+		// table[i*2^w + d] = b^d for 0 < d < 2^w
+		mpz_init_set(table[i * (1 << w) + 1], b[i]);
+		for (d = 2; d < (1 << w); d++) {
+			mpz_init(table[i * (1 << w) + d]);
+			mpz_mul(table[i * (1 << w) + d], table[i * (1 << w) + d - 1], b[i]);
+			mpz_mod(table[i * (1 << w) + d], table[i * (1 << w) + d], mod);
+		}
+	}
+
+	mpz_init_set_ui(acc, 1);
+	windows = ((long) bits + w - 1) / w;
+	for (j = windows - 1; j >= 0; j--) {
+		if (j < windows - 1) {
+			for (s = 0; s < w; s++) {
+				mpz_mul(acc, acc, acc);
+				mpz_mod(acc, acc, mod);
+			}
+		}
+		for (i = 0; i < k; i++) {
+			d = 0;
+			for (s = w - 1; s >= 0; s--) {
+				d = (d << 1) | mpz_tstbit(e[i], j * w + s);
+			}
+			if (d != 0) {
+				mpz_mul(acc, acc, table[i * (1 << w) + d]);
+				mpz_mod(acc, acc, mod);
+			}
+		}
+	}
+	buf = mpz_to_bytes(acc, len);
+
+	for (i = 0; i < k; i++) {
+		mpz_clear(b[i]);
+		mpz_clear(e[i]);
+		for (d = 1; d < (1 << w); d++) {
+			mpz_clear(table[i * (1 << w) + d]);
+		}
+	}
+	mpz_clear(mod);
+	mpz_clear(acc);
+	free(b);
+	free(e);
+	free(table);
+	return buf;
+}
+*/
+import "C"
+import (
+	"fmt"
+	"math/big"
+	"unsafe"
+)
+
+var get_rand = (*[0]byte)(unsafe.Pointer(C.paillier_get_rand_devurandom))
+
+const defaultBackendName = "libpaillier"
+
+func init() {
+	RegisterBackend(cgoBackend{})
+}
+
+// cgoBackend calls libpaillier and gmp through cgo
+type cgoBackend struct{}
+
+func (cgoBackend) Name() string {
+	return "libpaillier"
+}
+
+/*
+void paillier_keygen(int modulusbits,
+					 paillier_pubkey_t** pub,
+					 paillier_prvkey_t** prv,
+					 paillier_get_rand_t get_rand )
+ */
+func (cgoBackend) KeyGen(bits int) (*PrivateKey, error) {
+	var pubkey_c *C.paillier_pubkey_t
+	var prvkey_c *C.paillier_prvkey_t
+	C.paillier_keygen(C.int(bits), &pubkey_c, &prvkey_c, get_rand)
+	defer C.paillier_freepubkey(pubkey_c)
+	defer C.paillier_freeprvkey(prvkey_c)
+
+	pub := NewPublicKey(mpzToBig(&pubkey_c.n[0]))
+	sk := NewPrivateKey(pub, mpzToBig(&prvkey_c.lambda[0]))
+	// libpaillier drops p and q, they are recovered from lambda for the CRT
+	p, q, err := factorModulus(sk.N, sk.Lambda)
+	if err != nil {
+		return nil, err
+	}
+	sk, err = sk.withPrimes(p, q)
+	if err != nil {
+		return nil, err
+	}
+	return sk.WithBackend(cgoBackend{}), nil
+}
+
+//paillier_ciphertext_t* paillier_enc(paillier_ciphertext_t* res,
+//									  paillier_pubkey_t* pub,
+//									  paillier_plaintext_t* pt,
+//									  paillier_get_rand_t get_rand )
+func (cgoBackend) Encrypt(pk *PublicKey, msg *big.Int) (Ciphertext, error) {
+	pubkey_c := pk.toC()
+	defer C.paillier_freepubkey(pubkey_c)
+	pt := plaintextToC(msg)
+	defer C.paillier_freeplaintext(pt)
+
+	// encrypt with pubkey
+	ct := C.paillier_enc(nil, pubkey_c, pt, get_rand)
+	defer C.paillier_freeciphertext(ct)
+	return pk.ciphertextFromC(ct), nil
+}
+
+//paillier_plaintext_t* paillier_dec(paillier_plaintext_t* res,
+//									 paillier_pubkey_t* pub,
+//							 		 paillier_prvkey_t* prv,
+//							 		 paillier_ciphertext_t* ct );
+func (cgoBackend) Decrypt(sk *PrivateKey, cipher Ciphertext) (*big.Int, error) {
+	if sk.crt != nil {
+		return decryptCRTC(sk, cipher), nil
+	}
+	pubkey_c := sk.PublicKey.toC()
+	defer C.paillier_freepubkey(pubkey_c)
+	prvkey_c := sk.toC()
+	defer C.paillier_freeprvkey(prvkey_c)
+	ct := sk.PublicKey.ciphertextToC(cipher)
+	defer C.paillier_freeciphertext(ct)
+
+	// decrypt with prvkey
+	pt := C.paillier_dec(nil, pubkey_c, prvkey_c, ct)
+	defer C.paillier_freeplaintext(pt)
+	return sk.PublicKey.plaintextFromC(pt), nil
+}
+
+//void paillier_mul(paillier_pubkey_t* pub,
+//					paillier_ciphertext_t* res,
+//					paillier_ciphertext_t* ct0,
+//					paillier_ciphertext_t* ct1 );
+func (cgoBackend) Add(pk *PublicKey, cipher1, cipher2 Ciphertext) (Ciphertext, error) {
+	pubkey_c := pk.toC()
+	defer C.paillier_freepubkey(pubkey_c)
+	ct1 := pk.ciphertextToC(cipher1)
+	defer C.paillier_freeciphertext(ct1)
+	ct2 := pk.ciphertextToC(cipher2)
+	defer C.paillier_freeciphertext(ct2)
+
+	// multiply using pubkey and two ciphertexts
+	C.paillier_mul(pubkey_c, ct2, ct1, ct2)
+	return pk.ciphertextFromC(ct2), nil
+}
+
+//void paillier_exp(paillier_pubkey_t* pub,
+//					paillier_ciphertext_t* res,
+//					paillier_ciphertext_t* ct,
+//					paillier_plaintext_t* pt )
+func (cgoBackend) MulScalar(pk *PublicKey, cipher Ciphertext, scalar *big.Int) (Ciphertext, error) {
+	pubkey_c := pk.toC()
+	defer C.paillier_freepubkey(pubkey_c)
+	pt := plaintextToC(scalar)
+	defer C.paillier_freeplaintext(pt)
+	ct := pk.ciphertextToC(cipher)
+	defer C.paillier_freeciphertext(ct)
+
+	// multiply ciphertext by a plaintext number
+	C.paillier_exp(pubkey_c, ct, ct, pt)
+	return pk.ciphertextFromC(ct), nil
+}
+
+//char* paillier_pubkey_to_hex( paillier_pubkey_t* pub );
+func (cgoBackend) MarshalPublicKey(pk *PublicKey) string {
+	pubkey_c := pk.toC()
+	defer C.paillier_freepubkey(pubkey_c)
+	str := C.paillier_pubkey_to_hex(pubkey_c)
+	defer C.free(unsafe.Pointer(str))
+	return C.GoString(str)
+}
+
+//paillier_pubkey_t* paillier_pubkey_from_hex( char* str );
+func (b cgoBackend) UnmarshalPublicKey(s string) (*PublicKey, error) {
+	// gmp leaves the value undefined on malformed input
+	if !isHex(s) {
+		return nil, fmt.Errorf("%w: invalid hex", ErrInvalidPublicKey)
+	}
+	str := C.CString(s)
+	defer C.free(unsafe.Pointer(str))
+	pubkey_c := C.paillier_pubkey_from_hex(str)
+	defer C.paillier_freepubkey(pubkey_c)
+	return NewPublicKey(mpzToBig(&pubkey_c.n[0])).WithBackend(b), nil
+}
+
+//char* paillier_prvkey_to_hex( paillier_prvkey_t* prv );
+func (cgoBackend) MarshalPrivateKey(sk *PrivateKey) string {
+	prvkey_c := sk.toC()
+	defer C.paillier_freeprvkey(prvkey_c)
+	str := C.paillier_prvkey_to_hex(prvkey_c)
+	defer C.free(unsafe.Pointer(str))
+	return C.GoString(str)
+}
+
+//paillier_prvkey_t* paillier_prvkey_from_hex( char* str,
+//											 paillier_pubkey_t* pub );
+func (b cgoBackend) UnmarshalPrivateKey(pk *PublicKey, s string) (*PrivateKey, error) {
+	if !isHex(s) {
+		return nil, fmt.Errorf("%w: invalid hex", ErrInvalidPrivateKey)
+	}
+	str := C.CString(s)
+	defer C.free(unsafe.Pointer(str))
+	pubkey_c := pk.toC()
+	defer C.paillier_freepubkey(pubkey_c)
+	prvkey_c := C.paillier_prvkey_from_hex(str, pubkey_c)
+	defer C.paillier_freeprvkey(prvkey_c)
+	return NewPrivateKey(pk, mpzToBig(&prvkey_c.lambda[0])).WithBackend(b), nil
+}
+
+// conversions between the go types and libpaillier structs, the C structs
+// are allocated for the caller and must be freed with paillier_free*
+func (pk *PublicKey) toC() *C.paillier_pubkey_t {
+	n := bigToC(pk.N)
+	defer C.free(n)
+	n2 := bigToC(pk.NSquared)
+	defer C.free(n2)
+	return C.pubkey_from_bytes(C.int(pk.Bits),
+		n, C.int(len(pk.N.Bytes())), n2, C.int(len(pk.NSquared.Bytes())))
+}
+
+func (sk *PrivateKey) toC() *C.paillier_prvkey_t {
+	lambda := bigToC(sk.Lambda)
+	defer C.free(lambda)
+	x := bigToC(sk.X)
+	defer C.free(x)
+	return C.prvkey_from_bytes(lambda, C.int(len(sk.Lambda.Bytes())), x, C.int(len(sk.X.Bytes())))
+}
+
+func (pk *PublicKey) ciphertextToC(cipher Ciphertext) *C.paillier_ciphertext_t {
+	b := cipher.C.Bytes()
+	buf := C.CBytes(b)
+	defer C.free(buf)
+	return C.paillier_ciphertext_from_bytes(buf, C.int(len(b)))
+}
+
+func (pk *PublicKey) ciphertextFromC(ct *C.paillier_ciphertext_t) Ciphertext {
+	return pk.NewCiphertext(mpzToBig(&ct.c[0]))
+}
+
+//paillier_plaintext_t* paillier_plaintext_from_bytes( void* m, int len );
+func plaintextToC(m *big.Int) *C.paillier_plaintext_t {
+	b := m.Bytes()
+	buf := C.CBytes(b)
+	defer C.free(buf)
+	return C.paillier_plaintext_from_bytes(buf, C.int(len(b)))
+}
+
+//void* paillier_plaintext_to_bytes( int len, paillier_plaintext_t* pt );
+func (pk *PublicKey) plaintextFromC(pt *C.paillier_plaintext_t) *big.Int {
+	l := C.int(pk.plaintextLen())
+	buf := C.paillier_plaintext_to_bytes(l, pt)
+	defer C.free(buf)
+	return new(big.Int).SetBytes(C.GoBytes(buf, l))
+}
+
+// decryptCRTC is decryptCRT on gmp
+func decryptCRTC(sk *PrivateKey, cipher Ciphertext) *big.Int {
+	operands := []*big.Int{cipher.C, sk.p, sk.q, sk.crt.hp, sk.crt.hq, sk.crt.qInv}
+	in := (*[6]unsafe.Pointer)(C.malloc(C.size_t(6 * unsafe.Sizeof(uintptr(0)))))
+	defer C.free(unsafe.Pointer(in))
+	var inlen [6]C.int
+	for i, x := range operands {
+		in[i] = bigToC(x)
+		defer C.free(in[i])
+		inlen[i] = C.int(len(x.Bytes()))
+	}
+	var l C.int
+	buf := C.dec_crt(&in[0], &inlen[0], &l)
+	defer C.free(buf)
+	return new(big.Int).SetBytes(C.GoBytes(buf, l))
+}
+
+// MultiExp is multiExp on gmp
+func (cgoBackend) MultiExp(pk *PublicKey, bases, exps []*big.Int) *big.Int {
+	k := len(bases)
+	ptrSize := C.size_t(unsafe.Sizeof(uintptr(0)))
+	basesC := (*[1 << 28]unsafe.Pointer)(C.malloc(C.size_t(k) * ptrSize))[:k:k]
+	defer C.free(unsafe.Pointer(&basesC[0]))
+	expsC := (*[1 << 28]unsafe.Pointer)(C.malloc(C.size_t(k) * ptrSize))[:k:k]
+	defer C.free(unsafe.Pointer(&expsC[0]))
+	baselen := make([]C.int, k)
+	explen := make([]C.int, k)
+	maxBits := 0
+	for i := range bases {
+		basesC[i] = bigToC(bases[i])
+		defer C.free(basesC[i])
+		baselen[i] = C.int(len(bases[i].Bytes()))
+		expsC[i] = bigToC(exps[i])
+		defer C.free(expsC[i])
+		explen[i] = C.int(len(exps[i].Bytes()))
+		if exps[i].BitLen() > maxBits {
+			maxBits = exps[i].BitLen()
+		}
+	}
+	m := bigToC(pk.NSquared)
+	defer C.free(m)
+
+	var l C.int
+	buf := C.multi_exp(C.int(k), &basesC[0], &baselen[0], &expsC[0], &explen[0],
+		m, C.int(len(pk.NSquared.Bytes())), C.int(strausWindow(maxBits)), &l)
+	defer C.free(buf)
+	return new(big.Int).SetBytes(C.GoBytes(buf, l))
+}
+
+func bigToC(x *big.Int) unsafe.Pointer {
+	return C.CBytes(x.Bytes())
+}
+
+func mpzToBig(z C.mpz_ptr) *big.Int {
+	var l C.int
+	buf := C.mpz_to_bytes(z, &l)
+	defer C.free(buf)
+	return new(big.Int).SetBytes(C.GoBytes(buf, l))
+}
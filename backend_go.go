@@ -0,0 +1,124 @@
+package pailliersdk
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// goBackend is a pure go implementation on top of math/big, its keys and
+// ciphertexts are interchangeable with the libpaillier ones.
+type goBackend struct{}
+
+func init() {
+	RegisterBackend(goBackend{})
+}
+
+func (goBackend) Name() string {
+	return "go"
+}
+
+func (goBackend) KeyGen(bits int) (*PrivateKey, error) {
+	for {
+		// same as libpaillier, two primes of bits/2 until n has exactly bits bits
+		p, err := rand.Prime(rand.Reader, bits/2)
+		if err != nil {
+			return nil, err
+		}
+		q, err := rand.Prime(rand.Reader, bits/2)
+		if err != nil {
+			return nil, err
+		}
+		n := new(big.Int).Mul(p, q)
+		if p.Cmp(q) == 0 || n.BitLen() != bits {
+			continue
+		}
+		p1 := new(big.Int).Sub(p, one)
+		q1 := new(big.Int).Sub(q, one)
+		gcd := new(big.Int).GCD(nil, nil, p1, q1)
+		lambda := new(big.Int).Mul(p1, q1)
+		lambda.Div(lambda, gcd)
+		if new(big.Int).GCD(nil, nil, lambda, n).Cmp(one) != 0 {
+			continue
+		}
+		sk, err := NewPrivateKey(NewPublicKey(n), lambda).withPrimes(p, q)
+		if err != nil {
+			return nil, err
+		}
+		return sk.WithBackend(goBackend{}), nil
+	}
+}
+
+// c = g^m * r^n mod n^2 where g^m = 1 + m*n
+func (goBackend) Encrypt(pk *PublicKey, msg *big.Int) (Ciphertext, error) {
+	r, err := randomUnit(pk.N)
+	if err != nil {
+		return Ciphertext{}, err
+	}
+	c := new(big.Int).Exp(r, pk.N, pk.NSquared)
+	gm := new(big.Int).Mul(msg, pk.N)
+	gm.Add(gm, one)
+	c.Mul(c, gm)
+	return pk.NewCiphertext(c.Mod(c, pk.NSquared)), nil
+}
+
+// m = L(c^lambda mod n^2) * x mod n where L(u) = (u-1)/n, or modulo p^2 and
+// q^2 when the key knows p and q
+func (goBackend) Decrypt(sk *PrivateKey, cipher Ciphertext) (*big.Int, error) {
+	if sk.crt != nil {
+		return sk.decryptCRT(cipher), nil
+	}
+	u := new(big.Int).Exp(cipher.C, sk.Lambda, sk.NSquared)
+	u.Sub(u, one)
+	u.Div(u, sk.N)
+	u.Mul(u, sk.X)
+	return u.Mod(u, sk.N), nil
+}
+
+func (goBackend) Add(pk *PublicKey, cipher1, cipher2 Ciphertext) (Ciphertext, error) {
+	c := new(big.Int).Mul(cipher1.C, cipher2.C)
+	return pk.NewCiphertext(c.Mod(c, pk.NSquared)), nil
+}
+
+func (goBackend) MulScalar(pk *PublicKey, cipher Ciphertext, scalar *big.Int) (Ciphertext, error) {
+	return pk.NewCiphertext(new(big.Int).Exp(cipher.C, scalar, pk.NSquared)), nil
+}
+
+func (goBackend) MarshalPublicKey(pk *PublicKey) string {
+	return pk.N.Text(16)
+}
+
+func (b goBackend) UnmarshalPublicKey(s string) (*PublicKey, error) {
+	n, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid hex", ErrInvalidPublicKey)
+	}
+	return NewPublicKey(n).WithBackend(b), nil
+}
+
+func (goBackend) MarshalPrivateKey(sk *PrivateKey) string {
+	return sk.Lambda.Text(16)
+}
+
+func (b goBackend) UnmarshalPrivateKey(pk *PublicKey, s string) (*PrivateKey, error) {
+	lambda, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid hex", ErrInvalidPrivateKey)
+	}
+	return NewPrivateKey(pk, lambda).WithBackend(b), nil
+}
+
+// randomUnit returns a uniformly random element of Z*_n
+func randomUnit(n *big.Int) (*big.Int, error) {
+	for i := 0; i < 100; i++ {
+		r, err := rand.Int(rand.Reader, n)
+		if err != nil {
+			return nil, err
+		}
+		if r.Sign() > 0 && new(big.Int).GCD(nil, nil, r, n).Cmp(one) == 0 {
+			return r, nil
+		}
+	}
+	return nil, errors.New("failed to sample a unit of Z*_n")
+}
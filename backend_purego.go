@@ -0,0 +1,6 @@
+//go:build !cgo || purego
+// +build !cgo purego
+
+package pailliersdk
+
+const defaultBackendName = "go"
@@ -0,0 +1,57 @@
+//go:build cgo && !purego
+// +build cgo,!purego
+
+package pailliersdk
+
+import (
+	"math/big"
+	"testing"
+)
+
+// keys and ciphertexts produced by one backend must work in the other
+func TestCrossBackend(t *testing.T) {
+	backends := map[string]Backend{"cgo": cgoBackend{}, "go": goBackend{}}
+	for genName, gen := range backends {
+		sk, err := gen.KeyGen(testBit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// keys travel as hex between the backends
+		pk, err := ParsePublicKey(sk.PublicKey.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		sk, err = ParsePrivateKey(pk, sk.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for encName, enc := range backends {
+			m1 := big.NewInt(int64(plaintext1))
+			m2, _ := new(big.Int).SetString("340282366920938463463374607431768211457", 10)
+			c1, err := enc.Encrypt(pk, m1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c2, err := enc.Encrypt(pk, m2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for decName, dec := range backends {
+				// ciphertexts travel as hex as well
+				c1, _ := ParseCiphertext(pk, c1.String())
+				c2, _ := ParseCiphertext(pk, c2.String())
+				sum, _ := dec.Add(pk, c1, c2)
+				prod, _ := dec.MulScalar(pk, c1, big.NewInt(int64(scaler)))
+
+				expect := new(big.Int).Add(m1, m2)
+				if res, _ := dec.Decrypt(sk, sum); res.Cmp(expect) != 0 {
+					t.Fatalf("key %s, enc %s, dec %s: decrypted sum %s, expect %s", genName, encName, decName, res, expect)
+				}
+				expect = new(big.Int).Mul(m1, big.NewInt(int64(scaler)))
+				if res, _ := dec.Decrypt(sk, prod); res.Cmp(expect) != 0 {
+					t.Fatalf("key %s, enc %s, dec %s: decrypted product %s, expect %s", genName, encName, decName, res, expect)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package pailliersdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"runtime"
+	"sync"
+)
+
+// batch encryption and decryption, the items are spread over a number of
+// goroutines and the results keep the order of the inputs
+
+// BatchError reports the items of a batch which failed.
+type BatchError struct {
+	Errors []error // one per item, nil for the items that succeeded
+}
+
+func (e *BatchError) Error() string {
+	failed := 0
+	first := -1
+	for i, err := range e.Errors {
+		if err != nil {
+			failed++
+			if first < 0 {
+				first = i
+			}
+		}
+	}
+	return fmt.Sprintf("%d of %d items failed, item %d: %v", failed, len(e.Errors), first, e.Errors[first])
+}
+
+// Unwrap returns the error of the first failed item, so that errors.Is
+// matches e.g. context.Canceled or ErrPlaintextTooLarge.
+func (e *BatchError) Unwrap() error {
+	for _, err := range e.Errors {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BatchOption configures EncryptBatch and DecryptBatch
+type BatchOption func(*batchConfig)
+
+type batchConfig struct {
+	workers   int
+	encryptor *Encryptor
+}
+
+// WithBatchWorkers sets the number of goroutines, default the number of CPUs.
+func WithBatchWorkers(workers int) BatchOption {
+	return func(c *batchConfig) {
+		c.workers = workers
+	}
+}
+
+// WithBatchEncryptor makes EncryptBatch draw its randomness from the pool of
+// enc, which must encrypt under the same public key.
+func WithBatchEncryptor(enc *Encryptor) BatchOption {
+	return func(c *batchConfig) {
+		c.encryptor = enc
+	}
+}
+
+// EncryptBatch encrypts plaintexts 0 <= m < n. On failure the ciphertexts of
+// the successful items are still returned together with a *BatchError, items
+// not reached before ctx is done fail with ctx.Err().
+func EncryptBatch(ctx context.Context, pk *PublicKey, msgs []*big.Int, opts ...BatchOption) ([]Ciphertext, error) {
+	cfg := newBatchConfig(opts)
+	if cfg.encryptor != nil && cfg.encryptor.PublicKey().N.Cmp(pk.N) != 0 {
+		return nil, fmt.Errorf("%w: the encryptor encrypts under another public key", ErrInvalidPublicKey)
+	}
+	res := make([]Ciphertext, len(msgs))
+	err := runBatch(ctx, len(msgs), cfg.workers, func(i int) error {
+		var err error
+		if cfg.encryptor != nil {
+			res[i], err = cfg.encryptor.EncryptBig(msgs[i])
+		} else {
+			res[i], err = EncryptBig(pk, msgs[i])
+		}
+		return err
+	})
+	return res, err
+}
+
+// DecryptBatch decrypts ciphertexts to plaintexts in [0, n), errors are
+// reported as in EncryptBatch.
+func DecryptBatch(ctx context.Context, sk *PrivateKey, ciphers []Ciphertext, opts ...BatchOption) ([]*big.Int, error) {
+	cfg := newBatchConfig(opts)
+	res := make([]*big.Int, len(ciphers))
+	err := runBatch(ctx, len(ciphers), cfg.workers, func(i int) error {
+		var err error
+		res[i], err = DecryptBig(sk, ciphers[i])
+		return err
+	})
+	return res, err
+}
+
+// Sum returns a ciphertext of the sum of all plaintexts, an encryption of zero
+// for no ciphertexts. The ciphertexts are multiplied pairwise level by level,
+// the products of a level in parallel.
+func Sum(pk *PublicKey, ciphers []Ciphertext, opts ...BatchOption) (Ciphertext, error) {
+	if len(ciphers) == 0 {
+		return EncryptBig(pk, new(big.Int))
+	}
+	// validate once, the products of valid ciphertexts stay in Z*_{n^2}
+	for i, c := range ciphers {
+		if err := pk.ValidateCiphertext(c); err != nil {
+			return Ciphertext{}, fmt.Errorf("ciphertext %d: %w", i, err)
+		}
+	}
+	workers := newBatchConfig(opts).workers
+	level := ciphers
+	for len(level) > 1 {
+		next := make([]Ciphertext, (len(level)+1)/2)
+		err := runBatch(context.Background(), len(level)/2, workers, func(i int) error {
+			var err error
+			next[i], err = pk.Backend().Add(pk, level[2*i], level[2*i+1])
+			return err
+		})
+		if err != nil {
+			return Ciphertext{}, err
+		}
+		if len(level)%2 == 1 {
+			next[len(next)-1] = level[len(level)-1]
+		}
+		level = next
+	}
+	return level[0], nil
+}
+
+func newBatchConfig(opts []BatchOption) *batchConfig {
+	cfg := &batchConfig{workers: runtime.NumCPU()}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.workers < 1 {
+		cfg.workers = 1
+	}
+	return cfg
+}
+
+// runBatch calls f for the items 0..n-1 from the given number of goroutines
+func runBatch(ctx context.Context, n, workers int, f func(i int) error) error {
+	if workers > n {
+		workers = n
+	}
+	errs := make([]error, n)
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				errs[i] = f(i)
+			}
+		}()
+	}
+
+	i := 0
+feed:
+	for ; i < n; i++ {
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+	for ; i < n; i++ {
+		errs[i] = ctx.Err()
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			return &BatchError{Errors: errs}
+		}
+	}
+	return nil
+}
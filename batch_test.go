@@ -0,0 +1,117 @@
+package pailliersdk
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestBatch(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+	msgs := make([]*big.Int, 50)
+	for i := range msgs {
+		msgs[i] = big.NewInt(int64(i * i))
+	}
+	ciphers, err := EncryptBatch(context.Background(), pk, msgs, WithBatchWorkers(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plains, err := DecryptBatch(context.Background(), sk, ciphers, WithBatchWorkers(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, m := range plains {
+		if m.Cmp(msgs[i]) != 0 {
+			t.Fatalf("item %d decrypted %s, expect %s", i, m, msgs[i])
+		}
+	}
+
+	// randomness from a pool
+	enc := NewEncryptor(pk, WithPoolSize(8), WithWorkers(1))
+	defer enc.Close()
+	ciphers, err = EncryptBatch(context.Background(), pk, msgs, WithBatchEncryptor(enc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m, _ := DecryptBig(sk, ciphers[7]); m.Cmp(msgs[7]) != 0 {
+		t.Fatalf("decrypted %s, expect %s", m, msgs[7])
+	}
+	other, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherEnc := NewEncryptor(&other.PublicKey, WithPoolSize(1), WithWorkers(1))
+	defer otherEnc.Close()
+	if _, err := EncryptBatch(context.Background(), pk, msgs, WithBatchEncryptor(otherEnc)); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Fatalf("encryptor of another key, expect ErrInvalidPublicKey, got %v", err)
+	}
+
+	// failed items are reported by index, the others still succeed
+	bad := append([]*big.Int{}, msgs[:5]...)
+	bad[3] = pk.N
+	ciphers, err = EncryptBatch(context.Background(), pk, bad)
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || !errors.Is(err, ErrPlaintextTooLarge) {
+		t.Fatalf("expect a batch error, got %v", err)
+	}
+	for i, e := range batchErr.Errors {
+		if (e != nil) != (i == 3) {
+			t.Fatalf("item %d error %v", i, e)
+		}
+	}
+	if m, _ := DecryptBig(sk, ciphers[4]); m.Cmp(bad[4]) != 0 {
+		t.Fatalf("decrypted %s, expect %s", m, bad[4])
+	}
+}
+
+func TestBatchCancel(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	msgs := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+	_, err = EncryptBatch(ctx, &sk.PublicKey, msgs)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expect context.Canceled, got %v", err)
+	}
+}
+
+func TestSum(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+	// odd and even lengths exercise the element carried to the next level
+	for _, n := range []int{0, 1, 2, 7, 16} {
+		msgs := make([]*big.Int, n)
+		expect := int64(0)
+		for i := range msgs {
+			msgs[i] = big.NewInt(int64(i + 1))
+			expect += int64(i + 1)
+		}
+		ciphers, err := EncryptBatch(context.Background(), pk, msgs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sum, err := Sum(pk, ciphers, WithBatchWorkers(3))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m, _ := DecryptBig(sk, sum); m.Int64() != expect {
+			t.Fatalf("sum of %d ciphertexts is %s, expect %d", n, m, expect)
+		}
+	}
+
+	bad := []Ciphertext{pk.NewCiphertext(big.NewInt(1)), pk.NewCiphertext(pk.NSquared)}
+	if _, err := Sum(pk, bad); !errors.Is(err, ErrCiphertextOutOfRange) {
+		t.Fatalf("expect ErrCiphertextOutOfRange, got %v", err)
+	}
+}
@@ -2,4 +2,13 @@ package pailliersdk
 
 type PaillierConfig struct {
 	Enable   bool           `yaml:"enable"`
+	Backend  string         `yaml:"backend"`
+	// every method operating on ciphertexts under a commitment rejects
+	// inputs without a proof of plaintext knowledge: PaillierMul, PaillierExp,
+	// PaillierSub, PaillierNeg, PaillierAddPlain, PaillierRerandomize,
+	// PaillierSum, PaillierLinComb, PaillierVecAdd, PaillierVecMul,
+	// PaillierVecDot and PaillierVecSum. PaillierEnc, PaillierEncBatch,
+	// PaillierEncPacked and PaillierVecEnc then always return the proofs, see
+	// WithRequireProofs
+	RequireProofs bool      `yaml:"requireProofs"`
 }
@@ -0,0 +1,155 @@
+package pailliersdk
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Decryption modulo p^2 and q^2 recombined with the Chinese Remainder Theorem,
+// the exponentiations are half as long and on half sized numbers.
+//
+// A private key which knows p and q is serialized as "lambda:p:q" in hex,
+// keys in the lambda only format of libpaillier are still accepted and
+// decrypt modulo n^2.
+
+// crtValues are the values precomputed from p and q
+type crtValues struct {
+	pSquared, qSquared *big.Int
+	pMinus1, qMinus1   *big.Int
+	hp, hq             *big.Int // L_p(g^(p-1) mod p^2)^-1 mod p, and the same for q
+	qInv               *big.Int // q^-1 mod p
+}
+
+// NewPrivateKeyFromPrimes builds a private key from the prime factors of n,
+// the key decrypts with the CRT.
+func NewPrivateKeyFromPrimes(pub *PublicKey, p, q *big.Int) (*PrivateKey, error) {
+	p1 := new(big.Int).Sub(p, one)
+	q1 := new(big.Int).Sub(q, one)
+	gcd := new(big.Int).GCD(nil, nil, p1, q1)
+	lambda := new(big.Int).Mul(p1, q1)
+	lambda.Div(lambda, gcd)
+	if err := checkLambda(pub, lambda); err != nil {
+		return nil, err
+	}
+	return NewPrivateKey(pub, lambda).withPrimes(p, q)
+}
+
+// Primes reports the prime factors of n, nil for a lambda only key.
+func (sk *PrivateKey) Primes() (p, q *big.Int) {
+	if sk.crt == nil {
+		return nil, nil
+	}
+	return new(big.Int).Set(sk.p), new(big.Int).Set(sk.q)
+}
+
+// withPrimes returns a copy of the key which decrypts with the CRT.
+func (sk *PrivateKey) withPrimes(p, q *big.Int) (*PrivateKey, error) {
+	if p.Cmp(one) <= 0 || q.Cmp(one) <= 0 || p.Cmp(q) == 0 || new(big.Int).Mul(p, q).Cmp(sk.N) != 0 {
+		return nil, fmt.Errorf("%w: p*q does not match n", ErrInvalidPrivateKey)
+	}
+	v := &crtValues{
+		pSquared: new(big.Int).Mul(p, p),
+		qSquared: new(big.Int).Mul(q, q),
+		pMinus1:  new(big.Int).Sub(p, one),
+		qMinus1:  new(big.Int).Sub(q, one),
+		qInv:     new(big.Int).ModInverse(q, p),
+	}
+	// lambda must be the one of p and q or the two decryption paths disagree
+	if new(big.Int).Mod(sk.Lambda, v.pMinus1).Sign() != 0 || new(big.Int).Mod(sk.Lambda, v.qMinus1).Sign() != 0 {
+		return nil, fmt.Errorf("%w: lambda does not match p and q", ErrInvalidPrivateKey)
+	}
+	v.hp = crtH(sk.G, p, v.pMinus1, v.pSquared)
+	v.hq = crtH(sk.G, q, v.qMinus1, v.qSquared)
+	if v.qInv == nil || v.hp == nil || v.hq == nil {
+		return nil, fmt.Errorf("%w: p and q not coprime", ErrInvalidPrivateKey)
+	}
+	res := *sk
+	res.p = new(big.Int).Set(p)
+	res.q = new(big.Int).Set(q)
+	res.crt = v
+	return &res, nil
+}
+
+// crtH returns L_p(g^(p-1) mod p^2)^-1 mod p
+func crtH(g, p, pMinus1, pSquared *big.Int) *big.Int {
+	h := new(big.Int).Exp(g, pMinus1, pSquared)
+	h.Sub(h, one)
+	h.Div(h, p)
+	return h.ModInverse(h, p)
+}
+
+// decryptCRT computes m mod p and m mod q and recombines them,
+// m = mq + ((mp - mq) * q^-1 mod p) * q
+func (sk *PrivateKey) decryptCRT(cipher Ciphertext) *big.Int {
+	v := sk.crt
+	mp := crtDecryptPart(cipher.C, sk.p, v.pMinus1, v.pSquared, v.hp)
+	mq := crtDecryptPart(cipher.C, sk.q, v.qMinus1, v.qSquared, v.hq)
+	m := mp.Sub(mp, mq)
+	m.Mul(m, v.qInv)
+	m.Mod(m, sk.p)
+	m.Mul(m, sk.q)
+	return m.Add(m, mq)
+}
+
+// crtDecryptPart returns L_p(c^(p-1) mod p^2) * hp mod p
+func crtDecryptPart(c, p, pMinus1, pSquared, hp *big.Int) *big.Int {
+	m := new(big.Int).Mod(c, pSquared)
+	m.Exp(m, pMinus1, pSquared)
+	m.Sub(m, one)
+	m.Div(m, p)
+	m.Mul(m, hp)
+	return m.Mod(m, p)
+}
+
+// splitPrivateKey splits the "lambda:p:q" format, p and q are nil for the
+// lambda only format
+func splitPrivateKey(s string) (lambda string, p, q *big.Int, err error) {
+	parts := strings.Split(s, ":")
+	switch len(parts) {
+	case 1:
+		return s, nil, nil, nil
+	case 3:
+		p, okp := new(big.Int).SetString(parts[1], 16)
+		q, okq := new(big.Int).SetString(parts[2], 16)
+		if !okp || !okq {
+			return "", nil, nil, fmt.Errorf("%w: invalid hex", ErrInvalidPrivateKey)
+		}
+		return parts[0], p, q, nil
+	default:
+		return "", nil, nil, fmt.Errorf("%w: expect lambda or lambda:p:q", ErrInvalidPrivateKey)
+	}
+}
+
+// factorModulus recovers p and q from n and lambda. Since x^lambda = 1 mod n
+// for every unit x, a square root of 1 other than +-1 shows up while squaring
+// x^t up to x^lambda, lambda = 2^s * t, for at least half of the bases.
+func factorModulus(n, lambda *big.Int) (p, q *big.Int, err error) {
+	t := new(big.Int).Set(lambda)
+	s := 0
+	for t.Bit(0) == 0 {
+		t.Rsh(t, 1)
+		s++
+	}
+	nMinus1 := new(big.Int).Sub(n, one)
+	for a := int64(2); a < 200; a++ {
+		x := new(big.Int).Exp(big.NewInt(a), t, n)
+		if x.Cmp(one) == 0 || x.Cmp(nMinus1) == 0 {
+			continue
+		}
+		for i := 0; i < s; i++ {
+			y := new(big.Int).Exp(x, big.NewInt(2), n)
+			if y.Cmp(one) == 0 {
+				p = x.Sub(x, one)
+				p.GCD(nil, nil, p, n)
+				return p, new(big.Int).Div(n, p), nil
+			}
+			if y.Cmp(nMinus1) == 0 {
+				break
+			}
+			x = y
+		}
+	}
+	return nil, nil, errors.New("failed to factor n from lambda")
+}
@@ -0,0 +1,94 @@
+package pailliersdk
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestDecryptCRT(t *testing.T) {
+	for _, name := range []string{defaultBackendName, "go"} {
+		b, _ := LookupBackend(name)
+		sk, err := generateKey(b, testBit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p, q := sk.Primes(); p == nil || new(big.Int).Mul(p, q).Cmp(sk.N) != 0 {
+			t.Fatalf("%s: generated key does not know p and q", name)
+		}
+		pk := &sk.PublicKey
+		// the legacy format drops p and q and decrypts modulo n^2
+		legacyStr := strings.Split(sk.String(), ":")[0]
+		legacy, err := ParsePrivateKey(pk, legacyStr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p, _ := legacy.Primes(); p != nil {
+			t.Fatalf("%s: legacy key knows p and q", name)
+		}
+		if legacy.String() != legacyStr {
+			t.Fatalf("%s: legacy key serialized as %s", name, legacy.String())
+		}
+		parsed, err := ParsePrivateKey(pk, sk.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parsed.String() != sk.String() {
+			t.Fatalf("%s: extended key serialized as %s", name, parsed.String())
+		}
+
+		for _, m := range []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(12345), new(big.Int).Sub(pk.N, one)} {
+			ct, err := EncryptBig(pk, m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res1, _ := DecryptBig(parsed, ct)
+			res2, _ := DecryptBig(legacy, ct)
+			if res1.Cmp(m) != 0 || res2.Cmp(m) != 0 {
+				t.Fatalf("%s: decrypted %s with the crt and %s without, expect %s", name, res1, res2, m)
+			}
+		}
+	}
+}
+
+func TestParseExtendedPrivateKey(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+	parts := strings.Split(sk.String(), ":")
+	p, _ := new(big.Int).SetString(parts[1], 16)
+	wrong := new(big.Int).Add(p, big.NewInt(2)).Text(16)
+	for _, s := range []string{
+		parts[0] + ":" + parts[1],
+		parts[0] + ":" + wrong + ":" + parts[2],
+		parts[0] + ":" + parts[1] + ":" + parts[1],
+		parts[0] + ":" + parts[1] + ":zz",
+	} {
+		if _, err := ParsePrivateKey(pk, s); !errors.Is(err, ErrInvalidPrivateKey) {
+			t.Fatalf("parse %q, got %v", s, err)
+		}
+	}
+}
+
+func benchmarkDecrypt(b *testing.B, bits int, crt bool) {
+	sk, err := GenerateKey(bits)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !crt {
+		sk, _ = ParsePrivateKey(&sk.PublicKey, strings.Split(sk.String(), ":")[0])
+	}
+	ct, _ := sk.PublicKey.Encrypt(uint64(plaintext1))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := DecryptBig(sk, ct); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecrypt2048(b *testing.B)    { benchmarkDecrypt(b, 2048, false) }
+func BenchmarkDecryptCRT2048(b *testing.B) { benchmarkDecrypt(b, 2048, true) }
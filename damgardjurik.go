@@ -0,0 +1,225 @@
+package pailliersdk
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+)
+
+// Damgård–Jurik generalization of paillier with the same key pairs: for s >= 1
+// plaintexts live in Z_{n^s} and ciphertexts in Z*_{n^{s+1}},
+// c = (1+n)^m * r^{n^s} mod n^{s+1}. s = 1 is plain paillier, a larger s
+// encrypts s times the plaintext bits with a ciphertext expansion of (s+1)/s.
+
+// maxDJS is the largest s, it has to fit in the one byte ciphertext header
+const maxDJS = 255
+
+// DJCiphertext is a Damgård–Jurik ciphertext, it records its s. Build it with
+// NewDJCiphertext, a literal lacks the length to pad Bytes to.
+type DJCiphertext struct {
+	S    int
+	C    *big.Int
+	size int // serialized length of C in bytes, taken from the public key
+}
+
+// djModuli returns n^s and n^{s+1}
+func (pk *PublicKey) djModuli(s int) (ns, ns1 *big.Int, err error) {
+	if s < 1 || s > maxDJS {
+		return nil, nil, fmt.Errorf("%w: s must be in [1, %d], got %d", ErrInvalidCiphertext, maxDJS, s)
+	}
+	ns = new(big.Int).Exp(pk.N, big.NewInt(int64(s)), nil)
+	return ns, new(big.Int).Mul(ns, pk.N), nil
+}
+
+// NewDJCiphertext wraps c as a ciphertext of the given s under this public
+// key, an invalid s is left to ValidateDJCiphertext.
+func (pk *PublicKey) NewDJCiphertext(s int, c *big.Int) DJCiphertext {
+	_, ns1, err := pk.djModuli(s)
+	if err != nil {
+		return DJCiphertext{S: s, C: c}
+	}
+	return pk.newDJCiphertext(s, c, ns1)
+}
+
+func (pk *PublicKey) newDJCiphertext(s int, c, ns1 *big.Int) DJCiphertext {
+	return DJCiphertext{S: s, C: c, size: (ns1.BitLen() + 7) / 8}
+}
+
+// DJPlaintextBits returns the number of bits always fitting in a plaintext of
+// the given s.
+func (pk *PublicKey) DJPlaintextBits(s int) int {
+	return s * (pk.Bits - 1)
+}
+
+// ValidateDJCiphertext checks that 0 < c < n^{s+1} and gcd(c, n) = 1.
+func (pk *PublicKey) ValidateDJCiphertext(c DJCiphertext) error {
+	_, ns1, err := pk.djModuli(c.S)
+	if err != nil {
+		return err
+	}
+	if c.C == nil {
+		return fmt.Errorf("%w: empty ciphertext", ErrInvalidCiphertext)
+	}
+	if c.C.Sign() <= 0 || c.C.Cmp(ns1) >= 0 {
+		return ErrCiphertextOutOfRange
+	}
+	if new(big.Int).GCD(nil, nil, c.C, pk.N).Cmp(one) != 0 {
+		return fmt.Errorf("%w: not coprime with n", ErrCiphertextOutOfRange)
+	}
+	return nil
+}
+
+// DJEncrypt encrypts a plaintext 0 <= m < n^s.
+func DJEncrypt(pk *PublicKey, s int, m *big.Int) (DJCiphertext, error) {
+	ns, ns1, err := pk.djModuli(s)
+	if err != nil {
+		return DJCiphertext{}, err
+	}
+	if m.Sign() < 0 {
+		return DJCiphertext{}, fmt.Errorf("%w: negative message", ErrInvalidPlaintext)
+	}
+	if m.Cmp(ns) >= 0 {
+		return DJCiphertext{}, fmt.Errorf("%w: message must be less than n^%d", ErrPlaintextTooLarge, s)
+	}
+	r, err := randomUnit(pk.N)
+	if err != nil {
+		return DJCiphertext{}, err
+	}
+	c := new(big.Int).Exp(r, ns, ns1)
+	c.Mul(c, djPowG(pk.N, m, s, ns1))
+	return pk.newDJCiphertext(s, c.Mod(c, ns1), ns1), nil
+}
+
+// djPowG returns (1+n)^m mod n^{s+1} = sum_{k=0..s} binom(m, k) n^k
+func djPowG(n, m *big.Int, s int, ns1 *big.Int) *big.Int {
+	res := big.NewInt(1)
+	binom := big.NewInt(1)
+	nk := big.NewInt(1)
+	mod := new(big.Int).Set(ns1)
+	for k := 1; k <= s; k++ {
+		// binom(m, k) = binom(m, k-1) * (m-k+1) / k, only needed mod n^{s+1-k}
+		// as it is multiplied by n^k, k <= s is invertible mod n
+		f := new(big.Int).Sub(m, big.NewInt(int64(k-1)))
+		if f.Sign() <= 0 {
+			break
+		}
+		mod.Quo(mod, n)
+		binom.Mul(binom, f)
+		binom.Mul(binom, new(big.Int).ModInverse(big.NewInt(int64(k)), mod))
+		binom.Mod(binom, mod)
+		nk.Mul(nk, n)
+		t := new(big.Int).Mul(binom, nk)
+		res.Add(res, t)
+	}
+	return res.Mod(res, ns1)
+}
+
+// DJDecrypt decrypts to a plaintext in [0, n^s).
+func DJDecrypt(sk *PrivateKey, c DJCiphertext) (*big.Int, error) {
+	if err := sk.ValidateDJCiphertext(c); err != nil {
+		return nil, err
+	}
+	ns, ns1, _ := sk.djModuli(c.S)
+	// a = (1+n)^{m*lambda mod n^s}, the exponent is recovered digit by digit
+	a := new(big.Int).Exp(c.C, sk.Lambda, ns1)
+	i := djLog(sk.N, a, c.S)
+	mu := new(big.Int).ModInverse(sk.Lambda, ns)
+	i.Mul(i, mu)
+	return i.Mod(i, ns), nil
+}
+
+// djLog returns i in Z_{n^s} with a = (1+n)^i mod n^{s+1}, the algorithm of
+// Damgård and Jurik lifting i from mod n^j to mod n^{j+1}
+func djLog(n, a *big.Int, s int) *big.Int {
+	i := new(big.Int)
+	nj := new(big.Int).Set(n) // n^j
+	nj1 := new(big.Int).Mul(n, n)
+	for j := 1; j <= s; j++ {
+		// t1 = L(a mod n^{j+1})
+		t1 := new(big.Int).Mod(a, nj1)
+		t1.Sub(t1, one)
+		t1.Div(t1, n)
+		t2 := new(big.Int).Set(i)
+		nk := big.NewInt(1)   // n^{k-1}
+		fact := big.NewInt(1) // k!
+		for k := 2; k <= j; k++ {
+			i.Sub(i, one)
+			t2.Mul(t2, i)
+			t2.Mod(t2, nj)
+			nk.Mul(nk, n)
+			fact.Mul(fact, big.NewInt(int64(k)))
+			// t1 -= t2 * n^{k-1} / k! mod n^j
+			t := new(big.Int).Mul(t2, nk)
+			t.Mul(t, new(big.Int).ModInverse(fact, nj))
+			t1.Sub(t1, t)
+			t1.Mod(t1, nj)
+		}
+		i.Mod(t1, nj)
+		nj.Mul(nj, n)
+		nj1.Mul(nj1, n)
+	}
+	return i
+}
+
+// DJAdd returns a ciphertext of the sum of the plaintexts mod n^s, both
+// ciphertexts must have the same s.
+func DJAdd(pk *PublicKey, c1, c2 DJCiphertext) (DJCiphertext, error) {
+	if err := pk.ValidateDJCiphertext(c1); err != nil {
+		return DJCiphertext{}, err
+	}
+	if err := pk.ValidateDJCiphertext(c2); err != nil {
+		return DJCiphertext{}, err
+	}
+	if c1.S != c2.S {
+		return DJCiphertext{}, fmt.Errorf("%w: s %d and %d differ", ErrInvalidCiphertext, c1.S, c2.S)
+	}
+	_, ns1, _ := pk.djModuli(c1.S)
+	c := new(big.Int).Mul(c1.C, c2.C)
+	return pk.newDJCiphertext(c1.S, c.Mod(c, ns1), ns1), nil
+}
+
+// DJMulScalar returns a ciphertext of the plaintext multiplied by 0 <= k < n^s.
+func DJMulScalar(pk *PublicKey, c DJCiphertext, k *big.Int) (DJCiphertext, error) {
+	if err := pk.ValidateDJCiphertext(c); err != nil {
+		return DJCiphertext{}, err
+	}
+	ns, ns1, _ := pk.djModuli(c.S)
+	if k.Sign() < 0 || k.Cmp(ns) >= 0 {
+		return DJCiphertext{}, fmt.Errorf("%w: scalar must be in [0, n^%d)", ErrInvalidPlaintext, c.S)
+	}
+	return pk.newDJCiphertext(c.S, new(big.Int).Exp(c.C, k, ns1), ns1), nil
+}
+
+// Bytes returns a one byte header holding s followed by the big-endian
+// ciphertext padded to the length of n^{s+1}.
+func (c DJCiphertext) Bytes() []byte {
+	return append([]byte{byte(c.S)}, padBytes(c.C.Bytes(), c.size)...)
+}
+
+// String returns the hex encoded Bytes.
+func (c DJCiphertext) String() string {
+	return hex.EncodeToString(c.Bytes())
+}
+
+// ParseDJCiphertext parses the String of a ciphertext, s is read from the header.
+func ParseDJCiphertext(pk *PublicKey, s string) (DJCiphertext, error) {
+	if pk == nil {
+		return DJCiphertext{}, ErrInvalidPublicKey
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return DJCiphertext{}, fmt.Errorf("%w: invalid hex", ErrInvalidCiphertext)
+	}
+	_, ns1, err := pk.djModuli(int(b[0]))
+	if err != nil {
+		return DJCiphertext{}, err
+	}
+	c := pk.newDJCiphertext(int(b[0]), new(big.Int).SetBytes(b[1:]), ns1)
+	if len(b)-1 != c.size {
+		return DJCiphertext{}, fmt.Errorf("%w: expect %d bytes, got %d", ErrInvalidCiphertext, c.size+1, len(b))
+	}
+	if err := pk.ValidateDJCiphertext(c); err != nil {
+		return DJCiphertext{}, err
+	}
+	return c, nil
+}
@@ -0,0 +1,91 @@
+package pailliersdk
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestDamgardJurik(t *testing.T) {
+	sk, err := GenerateKey(512)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+	for _, s := range []int{1, 2, 3, 5} {
+		ns := new(big.Int).Exp(pk.N, big.NewInt(int64(s)), nil)
+		m1, _ := rand.Int(rand.Reader, ns)
+		m2, _ := rand.Int(rand.Reader, ns)
+		c1, err := DJEncrypt(pk, s, m1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c2, err := DJEncrypt(pk, s, m2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the header carries s through serialization
+		c1, err = ParseDJCiphertext(pk, c1.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c1.S != s {
+			t.Fatalf("parsed s %d, expect %d", c1.S, s)
+		}
+		if res, _ := DJDecrypt(sk, c1); res.Cmp(m1) != 0 {
+			t.Fatalf("s %d: decrypted %s, expect %s", s, res, m1)
+		}
+
+		sum, err := DJAdd(pk, c1, c2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := new(big.Int).Add(m1, m2)
+		if res, _ := DJDecrypt(sk, sum); res.Cmp(expect.Mod(expect, ns)) != 0 {
+			t.Fatalf("s %d: decrypted sum %s, expect %s", s, res, expect)
+		}
+		k := big.NewInt(12345)
+		prod, err := DJMulScalar(pk, c2, k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect = new(big.Int).Mul(m2, k)
+		if res, _ := DJDecrypt(sk, prod); res.Cmp(expect.Mod(expect, ns)) != 0 {
+			t.Fatalf("s %d: decrypted product %s, expect %s", s, res, expect)
+		}
+
+		if _, err := DJEncrypt(pk, s, ns); !errors.Is(err, ErrPlaintextTooLarge) {
+			t.Fatalf("s %d: message n^s, got %v", s, err)
+		}
+	}
+
+	// s = 1 is plain paillier
+	ct, _ := pk.Encrypt(uint64(plaintext1))
+	dj := pk.NewDJCiphertext(1, ct.C)
+	if res, _ := DJDecrypt(sk, dj); res.Int64() != int64(plaintext1) {
+		t.Fatalf("decrypted %s, expect %d", res, plaintext1)
+	}
+	if len(dj.Bytes()) != 1+len(ct.Bytes()) {
+		t.Fatalf("serialized %d bytes, expect %d", len(dj.Bytes()), 1+len(ct.Bytes()))
+	}
+
+	// the binomial expansion of (1+n)^m matches the exponentiation
+	for _, s := range []int{1, 2, 4} {
+		_, ns1, _ := pk.djModuli(s)
+		m, _ := rand.Int(rand.Reader, ns1)
+		expect := new(big.Int).Exp(new(big.Int).Add(pk.N, one), m, ns1)
+		if res := djPowG(pk.N, m, s, ns1); res.Cmp(expect) != 0 {
+			t.Fatalf("s %d: (1+n)^m mismatch", s)
+		}
+	}
+
+	c1, _ := DJEncrypt(pk, 1, big.NewInt(1))
+	c2, _ := DJEncrypt(pk, 2, big.NewInt(1))
+	if _, err := DJAdd(pk, c1, c2); !errors.Is(err, ErrInvalidCiphertext) {
+		t.Fatalf("different s, got %v", err)
+	}
+	if _, err := DJEncrypt(pk, 0, big.NewInt(1)); err == nil {
+		t.Fatal("s 0 should be rejected")
+	}
+}
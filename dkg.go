@@ -0,0 +1,546 @@
+package pailliersdk
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// Distributed generation of a paillier modulus and threshold key shares
+// without a dealer, after Boneh and Franklin. The parties are assumed honest
+// but curious, no single party learns p, q or lambda:
+//
+//  1. every party picks p_i and q_i, p = sum p_i and q = sum q_i, party 1 makes
+//     p_1 = q_1 = 3 mod 4 and the others p_i = q_i = 0 mod 4
+//  2. N = p*q is computed with the BGW protocol over a public prime field
+//  3. N is trial divided and checked with the biprimality test: for a common
+//     g of Jacobi symbol 1, g^{phi(N)/4} = +-1 mod N, each party contributing
+//     its share of phi(N)/4 in the exponent
+//  4. d = phi*beta*(gamma^-1 mod N) for a shared random beta and the revealed
+//     gamma = phi*beta + N*R, so d = 0 mod phi and d = 1 mod N, is shared over
+//     the integers with t-of-l Shamir shares as used by PartialDecrypt
+//
+// The biprimality test accepts a modulus of more than two prime factors with
+// probability at most 2^-Rounds, the moduli p^a*q^b the full test of Boneh and
+// Franklin also excludes are left out. The primes are not safe primes as
+// DealShares demands, so the proofs of the partial decryptions are unsound and
+// the key is marked Unverifiable: VerifyPartialDecryption and Combine refuse
+// its partial decryptions and CombineUnverified, which trusts every party,
+// decrypts them.
+
+// DKGMessage is a protocol message, To is 0 for a broadcast to all the other
+// parties. Messages of one step share the same Tag.
+type DKGMessage struct {
+	From, To int
+	Tag      string
+	Values   []*big.Int
+}
+
+// Transport delivers the messages between the parties. Send stamps the
+// sender, Receive returns the next message for the party in any order.
+type Transport interface {
+	Send(ctx context.Context, msg *DKGMessage) error
+	Receive(ctx context.Context) (*DKGMessage, error)
+}
+
+// DKGConfig configures the party Index of Parties, any Threshold of them
+// decrypt.
+type DKGConfig struct {
+	Index     int
+	Parties   int
+	Threshold int
+	Bits      int // size of the modulus
+	Rounds    int // rounds of the biprimality test, 40 if 0
+}
+
+const (
+	defaultBiprimeRounds = 40
+	// statistical security parameter of the masks
+	dkgStatBits = 128
+	// N is trial divided by the primes below
+	dkgSieveBound = 2000
+)
+
+var errInvalidDKGConfig = errors.New("invalid distributed key generation config, expect 3 <= parties and 1 <= threshold, index <= parties")
+
+type dkgParty struct {
+	cfg      DKGConfig
+	tr       Transport
+	field    *big.Int // BGW computes modulo this prime
+	lagrange []*big.Int
+	pending  map[string][]*DKGMessage
+	p, q     *big.Int // shares of the primes
+}
+
+// GenerateDistributedKey runs the protocol for one party, every party has to
+// run it with the same Parties, Threshold, Bits and Rounds. It returns the
+// common threshold public key, which is Unverifiable, and the key share of
+// the party.
+func GenerateDistributedKey(ctx context.Context, cfg DKGConfig, tr Transport) (*ThresholdPublicKey, *KeyShare, error) {
+	// BGW multiplies two polynomials of degree (Parties-1)/2 >= 1
+	if cfg.Parties < 3 || cfg.Parties > maxShares || cfg.Index < 1 || cfg.Index > cfg.Parties ||
+		cfg.Threshold < 1 || cfg.Threshold > cfg.Parties {
+		return nil, nil, errInvalidDKGConfig
+	}
+	if err := checkKeySize(cfg.Bits); err != nil {
+		return nil, nil, err
+	}
+	if cfg.Rounds <= 0 {
+		cfg.Rounds = defaultBiprimeRounds
+	}
+	p := &dkgParty{cfg: cfg, tr: tr, pending: make(map[string][]*DKGMessage)}
+	p.field = dkgField(cfg.Bits)
+	p.lagrange = lagrangeAtZero(cfg.Parties, p.field)
+
+	for attempt := 0; ; attempt++ {
+		n, err := p.modulus(ctx, attempt)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !sieve(n) {
+			continue
+		}
+		ok, err := p.biprime(ctx, attempt, n)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			return p.keyShares(ctx, attempt, n)
+		}
+	}
+}
+
+// dkgField returns the smallest prime above 2^{2*bits+2*stat+8}, large enough
+// for gamma and for recovering the carry of the additive shares of phi*beta
+func dkgField(bits int) *big.Int {
+	f := new(big.Int).Lsh(one, uint(2*bits+2*dkgStatBits+8))
+	f.Add(f, one)
+	for !f.ProbablyPrime(20) {
+		f.Add(f, big.NewInt(2))
+	}
+	return f
+}
+
+// lagrangeAtZero returns the coefficients interpolating a polynomial at 0 from
+// its values at 1..parties modulo the prime f
+func lagrangeAtZero(parties int, f *big.Int) []*big.Int {
+	res := make([]*big.Int, parties)
+	for i := 1; i <= parties; i++ {
+		num, den := big.NewInt(1), big.NewInt(1)
+		for j := 1; j <= parties; j++ {
+			if j != i {
+				num.Mul(num, big.NewInt(int64(j)))
+				den.Mul(den, big.NewInt(int64(j-i)))
+			}
+		}
+		den.Mod(den, f).ModInverse(den, f)
+		res[i-1] = num.Mul(num, den).Mod(num, f)
+	}
+	return res
+}
+
+// share returns the values at 1..parties of a random polynomial of the given
+// degree over the field with constant term secret
+func (p *dkgParty) share(secret *big.Int, degree int) ([]*big.Int, error) {
+	coeffs := []*big.Int{new(big.Int).Mod(secret, p.field)}
+	for i := 0; i < degree; i++ {
+		c, err := rand.Int(rand.Reader, p.field)
+		if err != nil {
+			return nil, err
+		}
+		coeffs = append(coeffs, c)
+	}
+	return evalPoly(coeffs, p.cfg.Parties, p.field), nil
+}
+
+// evalPoly evaluates at 1..parties, modulo m if m is not nil
+func evalPoly(coeffs []*big.Int, parties int, m *big.Int) []*big.Int {
+	res := make([]*big.Int, parties)
+	for i := range res {
+		x := big.NewInt(int64(i + 1))
+		v := new(big.Int)
+		for k := len(coeffs) - 1; k >= 0; k-- {
+			v.Mul(v, x).Add(v, coeffs[k])
+			if m != nil {
+				v.Mod(v, m)
+			}
+		}
+		res[i] = v
+	}
+	return res
+}
+
+// interpolate returns the constant term of the polynomial with the given
+// values at 1..parties
+func (p *dkgParty) interpolate(values [][]*big.Int) *big.Int {
+	res := new(big.Int)
+	for i, v := range values {
+		res.Add(res, new(big.Int).Mul(p.lagrange[i], v[0]))
+	}
+	return res.Mod(res, p.field)
+}
+
+// candidate draws a share of a prime of half bits
+func (p *dkgParty) candidate(half uint) (*big.Int, error) {
+	if p.cfg.Index == 1 {
+		x, err := rand.Int(rand.Reader, new(big.Int).Lsh(one, half-2))
+		if err != nil {
+			return nil, err
+		}
+		x.SetBit(x, int(half-1), 1)
+		return x.SetBit(x.SetBit(x, 0, 1), 1, 1), nil
+	}
+	// the other shares keep p below 2^half
+	max := new(big.Int).Lsh(one, half-2)
+	x, err := rand.Int(rand.Reader, max.Div(max, big.NewInt(int64(p.cfg.Parties))))
+	if err != nil {
+		return nil, err
+	}
+	return x.SetBit(x.SetBit(x, 0, 0), 1, 0), nil
+}
+
+// modulus draws new shares of p and q and computes N = p*q with BGW
+func (p *dkgParty) modulus(ctx context.Context, attempt int) (*big.Int, error) {
+	var err error
+	half := uint(p.cfg.Bits / 2)
+	if p.p, err = p.candidate(half); err != nil {
+		return nil, err
+	}
+	if p.q, err = p.candidate(half); err != nil {
+		return nil, err
+	}
+	degree := (p.cfg.Parties - 1) / 2
+	out, err := p.shareAll([]*big.Int{p.p, p.q, new(big.Int)}, []int{degree, degree, 2 * degree})
+	if err != nil {
+		return nil, err
+	}
+	tag := "modulus/" + strconv.Itoa(attempt)
+	in, err := p.exchange(ctx, tag+"/shares", out, 3)
+	if err != nil {
+		return nil, err
+	}
+	sums := p.sumShares(in, 3)
+	nj := sums[0].Mul(sums[0], sums[1]).Add(sums[0], sums[2]).Mod(sums[0], p.field)
+	all, err := p.broadcast(ctx, tag, []*big.Int{nj})
+	if err != nil {
+		return nil, err
+	}
+	return p.interpolate(all), nil
+}
+
+// shareAll shares each secret with the polynomial degree of the same index,
+// out[j] holds the values sent to party j+1
+func (p *dkgParty) shareAll(secrets []*big.Int, degrees []int) ([][]*big.Int, error) {
+	out := make([][]*big.Int, p.cfg.Parties)
+	for i, s := range secrets {
+		values, err := p.share(s, degrees[i])
+		if err != nil {
+			return nil, err
+		}
+		for j, v := range values {
+			out[j] = append(out[j], v)
+		}
+	}
+	return out, nil
+}
+
+// sumShares adds up the received shares of the same index
+func (p *dkgParty) sumShares(in [][]*big.Int, count int) []*big.Int {
+	sums := make([]*big.Int, count)
+	for k := range sums {
+		sums[k] = new(big.Int)
+		for _, values := range in {
+			sums[k].Add(sums[k], values[k])
+		}
+		sums[k].Mod(sums[k], p.field)
+	}
+	return sums
+}
+
+// sieve rejects the moduli with a small factor, a factor of p or q
+func sieve(n *big.Int) bool {
+	r := new(big.Int)
+	for _, s := range smallPrimes {
+		if r.Mod(n, big.NewInt(s)).Sign() == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+var smallPrimes = func() []int64 {
+	var primes []int64
+	for i := int64(3); i < dkgSieveBound; i += 2 {
+		if big.NewInt(i).ProbablyPrime(0) {
+			primes = append(primes, i)
+		}
+	}
+	return primes
+}()
+
+// biprime runs the biprimality test, party 1 exponentiates by
+// (N+1-p_1-q_1)/4 and the others by (p_i+q_i)/4
+func (p *dkgParty) biprime(ctx context.Context, attempt int, n *big.Int) (bool, error) {
+	e := new(big.Int).Add(p.p, p.q)
+	if p.cfg.Index == 1 {
+		e.Sub(new(big.Int).Add(n, one), e)
+	}
+	e.Rsh(e, 2)
+	for r := 0; r < p.cfg.Rounds; r++ {
+		tag := "biprime/" + strconv.Itoa(attempt) + "/" + strconv.Itoa(r)
+		g := commonBase(n, tag)
+		all, err := p.broadcast(ctx, tag, []*big.Int{new(big.Int).Exp(g, e, n)})
+		if err != nil {
+			return false, err
+		}
+		prod := big.NewInt(1)
+		for _, v := range all[1:] {
+			prod.Mul(prod, v[0]).Mod(prod, n)
+		}
+		if all[0][0].Cmp(prod) != 0 && all[0][0].Cmp(prod.Sub(n, prod)) != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// commonBase derives the same g of Jacobi symbol 1 mod n at every party
+func commonBase(n *big.Int, tag string) *big.Int {
+	for i := 0; ; i++ {
+		g := hashToInt(n, tag+"/"+strconv.Itoa(i))
+		if g.Sign() > 0 && big.Jacobi(g, n) == 1 {
+			return g
+		}
+	}
+}
+
+// hashToInt maps the tag and m to an integer in [0, m)
+func hashToInt(m *big.Int, tag string) *big.Int {
+	var b []byte
+	for i := 0; len(b) < (m.BitLen()+7)/8+dkgStatBits/8; i++ {
+		h := sha256.Sum256([]byte(tag + "/" + strconv.Itoa(i) + "/" + m.Text(16)))
+		b = append(b, h[:]...)
+	}
+	return new(big.Int).Mod(new(big.Int).SetBytes(b), m)
+}
+
+// keyShares shares d = phi*beta*(gamma^-1 mod N) between the parties
+func (p *dkgParty) keyShares(ctx context.Context, attempt int, n *big.Int) (*ThresholdPublicKey, *KeyShare, error) {
+	tag := "key/" + strconv.Itoa(attempt)
+	// additive shares of phi = N+1-p-q
+	phi := new(big.Int).Add(p.p, p.q)
+	phi.Neg(phi)
+	if p.cfg.Index == 1 {
+		phi.Add(phi, n).Add(phi, one)
+	}
+	beta, err := rand.Int(rand.Reader, new(big.Int).Lsh(n, dkgStatBits))
+	if err != nil {
+		return nil, nil, err
+	}
+	mask, err := rand.Int(rand.Reader, new(big.Int).Lsh(n, 2*dkgStatBits))
+	if err != nil {
+		return nil, nil, err
+	}
+	degree := (p.cfg.Parties - 1) / 2
+	out, err := p.shareAll([]*big.Int{phi, beta, mask, new(big.Int)}, []int{degree, degree, degree, 2 * degree})
+	if err != nil {
+		return nil, nil, err
+	}
+	in, err := p.exchange(ctx, tag+"/shares", out, 4)
+	if err != nil {
+		return nil, nil, err
+	}
+	sums := p.sumShares(in, 4)
+	// w is a share of phi*beta, u of gamma = phi*beta + N*R
+	w := new(big.Int).Mul(sums[0], sums[1])
+	w.Add(w, sums[3]).Mod(w, p.field)
+	u := new(big.Int).Mul(n, sums[2])
+	u.Add(u, w).Mod(u, p.field)
+	all, err := p.broadcast(ctx, tag, []*big.Int{u})
+	if err != nil {
+		return nil, nil, err
+	}
+	inv := new(big.Int).ModInverse(p.interpolate(all), n)
+	if inv == nil {
+		return nil, nil, errors.New("distributed key generation, gamma not invertible mod N")
+	}
+
+	// additive shares a_j = lambda_j*w_j of phi*beta sum up to phi*beta + c*f
+	// for some c < parties, c is recovered from the top bits of the a_j as
+	// phi*beta < f/2^stat
+	a := new(big.Int).Mul(p.lagrange[p.cfg.Index-1], w)
+	a.Mod(a, p.field)
+	top := new(big.Int).Lsh(a, dkgStatBits)
+	all, err = p.broadcast(ctx, tag+"/carry", []*big.Int{top.Div(top, p.field)})
+	if err != nil {
+		return nil, nil, err
+	}
+	carry := new(big.Int)
+	for _, v := range all {
+		carry.Add(carry, v[0])
+	}
+	carry.Add(carry, new(big.Int).Sub(new(big.Int).Lsh(one, dkgStatBits), one))
+	carry.Rsh(carry, dkgStatBits)
+	if p.cfg.Index == 1 {
+		a.Sub(a, carry.Mul(carry, p.field))
+	}
+	d := a.Mul(a, inv)
+
+	// reshare d_i over the integers, the non-negative coefficients keep the
+	// shares of d > 0 positive
+	coeffs := []*big.Int{d}
+	bound := new(big.Int).Lsh(one, uint(p.field.BitLen()+n.BitLen()+2*dkgStatBits))
+	for i := 1; i < p.cfg.Threshold; i++ {
+		c, err := rand.Int(rand.Reader, bound)
+		if err != nil {
+			return nil, nil, err
+		}
+		coeffs = append(coeffs, c)
+	}
+	values := evalPoly(coeffs, p.cfg.Parties, nil)
+	out = make([][]*big.Int, p.cfg.Parties)
+	for j, v := range values {
+		out[j] = []*big.Int{v}
+	}
+	if in, err = p.exchange(ctx, tag+"/reshare", out, 1); err != nil {
+		return nil, nil, err
+	}
+	s := new(big.Int)
+	for _, v := range in {
+		s.Add(s, v[0])
+	}
+
+	tpk := &ThresholdPublicKey{
+		PublicKey: *NewPublicKey(n),
+		T:         p.cfg.Threshold,
+		L:         p.cfg.Parties,
+		// the primes are not safe, see the comment at the top
+		Unverifiable: true,
+	}
+	v := hashToInt(tpk.NSquared, tag+"/v")
+	tpk.V = v.Mul(v, v).Mod(v, tpk.NSquared)
+	vk := new(big.Int).Mul(tpk.delta(), s)
+	if all, err = p.broadcast(ctx, tag+"/verify", []*big.Int{vk.Exp(tpk.V, vk, tpk.NSquared)}); err != nil {
+		return nil, nil, err
+	}
+	for _, v := range all {
+		tpk.VerificationKeys = append(tpk.VerificationKeys, v[0])
+	}
+	return tpk, &KeyShare{Index: p.cfg.Index, S: s}, nil
+}
+
+// exchange sends out[j] to party j+1 and returns the values received from
+// every party, its own included
+func (p *dkgParty) exchange(ctx context.Context, tag string, out [][]*big.Int, count int) ([][]*big.Int, error) {
+	for j, values := range out {
+		if j+1 == p.cfg.Index {
+			continue
+		}
+		if err := p.tr.Send(ctx, &DKGMessage{To: j + 1, Tag: tag, Values: values}); err != nil {
+			return nil, err
+		}
+	}
+	return p.collect(ctx, tag, out[p.cfg.Index-1], count)
+}
+
+// broadcast sends the values to all the parties and returns the values of
+// every party, its own included
+func (p *dkgParty) broadcast(ctx context.Context, tag string, values []*big.Int) ([][]*big.Int, error) {
+	if err := p.tr.Send(ctx, &DKGMessage{Tag: tag, Values: values}); err != nil {
+		return nil, err
+	}
+	return p.collect(ctx, tag, values, len(values))
+}
+
+// collect waits for the messages of the other parties with the tag, the
+// messages of later steps are kept for later
+func (p *dkgParty) collect(ctx context.Context, tag string, own []*big.Int, count int) ([][]*big.Int, error) {
+	res := make([][]*big.Int, p.cfg.Parties)
+	res[p.cfg.Index-1] = own
+	missing := p.cfg.Parties - 1
+	store := func(msg *DKGMessage) error {
+		if msg.From < 1 || msg.From > p.cfg.Parties || msg.From == p.cfg.Index || res[msg.From-1] != nil {
+			return fmt.Errorf("distributed key generation, unexpected message from %d at %s", msg.From, tag)
+		}
+		if len(msg.Values) != count {
+			return fmt.Errorf("distributed key generation, party %d sent %d values at %s, expect %d", msg.From, len(msg.Values), tag, count)
+		}
+		for _, v := range msg.Values {
+			if v == nil {
+				return fmt.Errorf("distributed key generation, party %d sent an empty value at %s", msg.From, tag)
+			}
+		}
+		res[msg.From-1] = msg.Values
+		missing--
+		return nil
+	}
+	for _, msg := range p.pending[tag] {
+		if err := store(msg); err != nil {
+			return nil, err
+		}
+	}
+	delete(p.pending, tag)
+	for missing > 0 {
+		msg, err := p.tr.Receive(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if msg.Tag != tag {
+			p.pending[msg.Tag] = append(p.pending[msg.Tag], msg)
+			continue
+		}
+		if err := store(msg); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+type memoryTransport struct {
+	index   int
+	inboxes []chan *DKGMessage
+}
+
+// NewMemoryTransports connects the given number of parties in memory, the
+// transport of party i is at index i-1.
+func NewMemoryTransports(parties int) []Transport {
+	inboxes := make([]chan *DKGMessage, parties)
+	for i := range inboxes {
+		// a party is at most one step ahead of the others
+		inboxes[i] = make(chan *DKGMessage, 4*parties)
+	}
+	res := make([]Transport, parties)
+	for i := range res {
+		res[i] = &memoryTransport{index: i + 1, inboxes: inboxes}
+	}
+	return res
+}
+
+func (t *memoryTransport) Send(ctx context.Context, msg *DKGMessage) error {
+	m := *msg
+	m.From = t.index
+	for j, inbox := range t.inboxes {
+		if j+1 == t.index || (m.To != 0 && m.To != j+1) {
+			continue
+		}
+		select {
+		case inbox <- &m:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (t *memoryTransport) Receive(ctx context.Context) (*DKGMessage, error) {
+	select {
+	case msg := <-t.inboxes[t.index-1]:
+		return msg, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,114 @@
+package pailliersdk
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDistributedKeyGeneration(t *testing.T) {
+	const parties, threshold, bits = 3, 2, 256
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	transports := NewMemoryTransports(parties)
+	tpks := make([]*ThresholdPublicKey, parties)
+	shares := make([]*KeyShare, parties)
+	errs := make([]error, parties)
+	var wg sync.WaitGroup
+	for i := range transports {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cfg := DKGConfig{Index: i + 1, Parties: parties, Threshold: threshold, Bits: bits}
+			tpks[i], shares[i], errs[i] = GenerateDistributedKey(ctx, cfg, transports[i])
+			if errs[i] != nil {
+				cancel()
+			}
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, tpk := range tpks[1:] {
+		if tpk.String() != tpks[0].String() {
+			t.Fatal("parties ended with different public keys")
+		}
+	}
+	tpk := tpks[0]
+	if tpk.Bits < bits-1 || tpk.Bits > bits {
+		t.Fatalf("modulus of %d bits, expect %d", tpk.Bits, bits)
+	}
+
+	// the shared key encrypts like any other and any t shares decrypt
+	pk, err := ParsePublicKey(tpk.PublicKey.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := big.NewInt(31415926)
+	c, err := EncryptBig(pk, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, set := range [][]int{{0, 1}, {2, 0}, {1, 2}} {
+		var partials []*PartialDecryption
+		for _, i := range set {
+			pd, err := PartialDecrypt(tpk, shares[i], c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			partials = append(partials, pd)
+		}
+		res, err := CombineUnverified(tpk, c, partials)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Cmp(m) != 0 {
+			t.Fatalf("shares %v: decrypted %s, expect %s", set, res, m)
+		}
+	}
+
+	// the modulus is not of safe primes, the proofs are refused and the mark
+	// survives the string form
+	pd, err := PartialDecrypt(tpk, shares[0], c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPartialDecryption(tpk, c, pd); !errors.Is(err, ErrUnverifiablePartial) {
+		t.Fatalf("expect ErrUnverifiablePartial, got %v", err)
+	}
+	tpk2, err := ParseThresholdPublicKey(tpk.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tpk2.Unverifiable {
+		t.Fatal("parsed key lost the Unverifiable mark")
+	}
+	pd2, err := PartialDecrypt(tpk2, shares[1], c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Combine(tpk2, c, []*PartialDecryption{pd, pd2}); !errors.Is(err, ErrUnverifiablePartial) {
+		t.Fatalf("expect ErrUnverifiablePartial, got %v", err)
+	}
+}
+
+func TestDistributedKeyGenerationConfig(t *testing.T) {
+	ctx := context.Background()
+	for _, cfg := range []DKGConfig{
+		{Index: 1, Parties: 2, Threshold: 1, Bits: 256},
+		{Index: 0, Parties: 3, Threshold: 2, Bits: 256},
+		{Index: 1, Parties: 3, Threshold: 4, Bits: 256},
+		{Index: 1, Parties: 3, Threshold: 2, Bits: 100},
+	} {
+		if _, _, err := GenerateDistributedKey(ctx, cfg, NewMemoryTransports(cfg.Parties)[0]); err == nil {
+			t.Fatalf("config %+v should be rejected", cfg)
+		}
+	}
+}
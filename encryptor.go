@@ -0,0 +1,169 @@
+package pailliersdk
+
+import (
+	"math/big"
+	"runtime"
+	"sync"
+)
+
+// Encryptor encrypts under a fixed public key with r^n mod n^2 drawn from a
+// pool filled in the background, an encryption is then (1 + m*n) * r^n mod n^2,
+// a single modular multiplication. When the pool runs dry it falls back to a
+// regular encryption.
+type Encryptor struct {
+	pk        *PublicKey
+	workers   int
+	threshold int
+
+	pool   chan *big.Int // r^n mod n^2, each value is used once
+	refill chan struct{} // wakes up the workers
+	done   chan struct{} // closed by Close
+	wg     sync.WaitGroup
+	once   sync.Once
+}
+
+// EncryptorOption configures an Encryptor
+type EncryptorOption func(*Encryptor)
+
+// WithPoolSize sets the number of precomputed values kept in the pool,
+// default 256.
+func WithPoolSize(size int) EncryptorOption {
+	return func(e *Encryptor) {
+		if size < 0 {
+			size = 0
+		}
+		e.pool = make(chan *big.Int, size)
+	}
+}
+
+// WithWorkers sets the number of goroutines filling the pool, default the
+// number of CPUs. With zero workers every encryption is computed on the fly.
+func WithWorkers(workers int) EncryptorOption {
+	return func(e *Encryptor) {
+		e.workers = workers
+	}
+}
+
+// WithRefillThreshold sets the pool length under which the workers start
+// refilling it, default half the pool size.
+func WithRefillThreshold(threshold int) EncryptorOption {
+	return func(e *Encryptor) {
+		e.threshold = threshold
+	}
+}
+
+// NewEncryptor starts the workers filling the pool, call Close to stop them.
+func NewEncryptor(pk *PublicKey, opts ...EncryptorOption) *Encryptor {
+	e := &Encryptor{
+		pk:        pk,
+		workers:   runtime.NumCPU(),
+		threshold: -1,
+		pool:      make(chan *big.Int, 256),
+		done:      make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	if e.threshold < 0 || e.threshold > cap(e.pool) {
+		e.threshold = cap(e.pool) / 2
+	}
+	if cap(e.pool) == 0 {
+		e.workers = 0
+	}
+	e.refill = make(chan struct{}, e.workers)
+	for i := 0; i < e.workers; i++ {
+		e.wg.Add(1)
+		go e.work()
+	}
+	return e
+}
+
+// PublicKey returns the key the encryptor encrypts under.
+func (e *Encryptor) PublicKey() *PublicKey {
+	return e.pk
+}
+
+// Len returns the number of precomputed values available.
+func (e *Encryptor) Len() int {
+	return len(e.pool)
+}
+
+// Close stops the workers, the encryptor keeps working on the fly.
+func (e *Encryptor) Close() {
+	e.once.Do(func() {
+		close(e.done)
+	})
+	e.wg.Wait()
+}
+
+// Encrypt encrypts msg.
+func (e *Encryptor) Encrypt(msg uint64) (Ciphertext, error) {
+	return e.EncryptBig(new(big.Int).SetUint64(msg))
+}
+
+// EncryptBig encrypts any plaintext 0 <= msg < n.
+func (e *Encryptor) EncryptBig(msg *big.Int) (Ciphertext, error) {
+	if err := e.pk.validatePlaintext(msg); err != nil {
+		return Ciphertext{}, err
+	}
+	var rn *big.Int
+	select {
+	case rn = <-e.pool:
+	default:
+		// pool empty, pay for r^n now
+		e.wakeWorkers()
+		return e.pk.Backend().Encrypt(e.pk, msg)
+	}
+	if len(e.pool) < e.threshold {
+		e.wakeWorkers()
+	}
+	c := new(big.Int).Mul(msg, e.pk.N)
+	c.Add(c, one)
+	c.Mul(c, rn)
+	return e.pk.NewCiphertext(c.Mod(c, e.pk.NSquared)), nil
+}
+
+// EncryptSigned encrypts a signed integer.
+func (e *Encryptor) EncryptSigned(v *big.Int) (Ciphertext, error) {
+	m, err := e.pk.EncodeSigned(v)
+	if err != nil {
+		return Ciphertext{}, err
+	}
+	return e.EncryptBig(m)
+}
+
+func (e *Encryptor) wakeWorkers() {
+	for i := 0; i < e.workers; i++ {
+		select {
+		case e.refill <- struct{}{}:
+		default:
+			return
+		}
+	}
+}
+
+// work fills the pool up, then sleeps until it drops under the threshold
+func (e *Encryptor) work() {
+	defer e.wg.Done()
+	zero := new(big.Int)
+	for {
+		for len(e.pool) < cap(e.pool) {
+			// an encryption of zero is exactly r^n mod n^2
+			ct, err := e.pk.Backend().Encrypt(e.pk, zero)
+			if err != nil {
+				// leave it to the on the fly path until the next refill
+				break
+			}
+			select {
+			case e.pool <- ct.C:
+			case <-e.done:
+				return
+			}
+		}
+		select {
+		case <-e.refill:
+		case <-e.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package pailliersdk
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEncryptor(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+
+	enc := NewEncryptor(pk, WithPoolSize(16), WithWorkers(2), WithRefillThreshold(8))
+	defer enc.Close()
+	deadline := time.Now().Add(10 * time.Second)
+	for enc.Len() < 16 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if enc.Len() < 16 {
+		t.Fatalf("pool holds %d values, expect 16", enc.Len())
+	}
+
+	// drain the pool well past its size from several goroutines
+	var wg sync.WaitGroup
+	errs := make(chan error, 64)
+	seen := make(chan string, 64)
+	for i := 0; i < 64; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ct, err := enc.EncryptSigned(big.NewInt(int64(i - 32)))
+			if err != nil {
+				errs <- err
+				return
+			}
+			seen <- ct.String()
+			res, err := DecryptSigned(sk, ct)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if res.Int64() != int64(i-32) {
+				t.Errorf("decrypted %s, expect %d", res, i-32)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	close(seen)
+	for err := range errs {
+		t.Fatal(err)
+	}
+	// every value of the pool is used once
+	distinct := make(map[string]bool)
+	for s := range seen {
+		distinct[s] = true
+	}
+	if len(distinct) != 64 {
+		t.Fatalf("%d distinct ciphertexts, expect 64", len(distinct))
+	}
+
+	if _, err := enc.EncryptBig(pk.N); err == nil {
+		t.Fatal("plaintext n should be rejected")
+	}
+}
+
+func TestEncryptorWithoutWorkers(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc := NewEncryptor(&sk.PublicKey, WithWorkers(0))
+	defer enc.Close()
+	ct, err := enc.Encrypt(uint64(plaintext1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enc.Len() != 0 {
+		t.Fatalf("pool holds %d values without workers", enc.Len())
+	}
+	if res, _ := sk.Decrypt(ct); res != uint64(plaintext1) {
+		t.Fatalf("decrypted %d, expect %d", res, plaintext1)
+	}
+}
+
+func BenchmarkEncrypt2048(b *testing.B) {
+	sk, _ := GenerateKey(2048)
+	pk := &sk.PublicKey
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pk.Encrypt(uint64(i))
+	}
+}
+
+func BenchmarkEncryptorPooled2048(b *testing.B) {
+	sk, _ := GenerateKey(2048)
+	enc := NewEncryptor(&sk.PublicKey, WithPoolSize(b.N), WithWorkers(0))
+	defer enc.Close()
+	// fill the pool by hand so only the multiplication is measured
+	for i := 0; i < b.N; i++ {
+		ct, _ := sk.PublicKey.Backend().Encrypt(&sk.PublicKey, new(big.Int))
+		enc.pool <- ct.C
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		enc.Encrypt(uint64(i))
+	}
+}
@@ -0,0 +1,76 @@
+package pailliersdk
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// errors returned by the checked paillier methods, match them with errors.Is
+var (
+	ErrInvalidKeySize       = errors.New("invalid key size")
+	ErrInvalidPublicKey     = errors.New("invalid public key")
+	ErrInvalidPrivateKey    = errors.New("invalid private key")
+	ErrInvalidCiphertext    = errors.New("invalid ciphertext")
+	ErrCiphertextOutOfRange = errors.New("ciphertext out of range")
+	ErrInvalidPlaintext     = errors.New("invalid plaintext")
+	ErrPlaintextTooLarge    = errors.New("plaintext too large")
+	ErrInvalidShare         = errors.New("invalid key share")
+	ErrInvalidPartial       = errors.New("invalid partial decryption")
+	ErrNotEnoughShares      = errors.New("not enough partial decryptions")
+	ErrUnverifiablePartial  = errors.New("partial decryption cannot be verified")
+	ErrInvalidProof         = errors.New("invalid proof")
+)
+
+// smallest modulus accepted by GenerateKey
+const minKeyBits = 128
+
+func checkKeySize(bits int) error {
+	// libpaillier draws two primes of bits/2 and loops until n has exactly bits bits
+	if bits < minKeyBits || bits%2 != 0 {
+		return fmt.Errorf("%w: %d bits, expect an even number no less than %d", ErrInvalidKeySize, bits, minKeyBits)
+	}
+	return nil
+}
+
+func checkModulus(n *big.Int) error {
+	if n.Sign() <= 0 || n.Bit(0) == 0 || n.BitLen() < minKeyBits {
+		return fmt.Errorf("%w: modulus must be odd and at least %d bits", ErrInvalidPublicKey, minKeyBits)
+	}
+	return nil
+}
+
+func checkLambda(pub *PublicKey, lambda *big.Int) error {
+	if lambda.Sign() <= 0 || lambda.Cmp(pub.N) >= 0 {
+		return fmt.Errorf("%w: lambda out of range", ErrInvalidPrivateKey)
+	}
+	if new(big.Int).GCD(nil, nil, lambda, pub.N).Cmp(one) != 0 {
+		return fmt.Errorf("%w: lambda not invertible mod n", ErrInvalidPrivateKey)
+	}
+	return nil
+}
+
+// ValidateCiphertext checks that 0 < c < n^2 and gcd(c, n) = 1.
+func (pk *PublicKey) ValidateCiphertext(c Ciphertext) error {
+	if c.C == nil {
+		return fmt.Errorf("%w: empty ciphertext", ErrInvalidCiphertext)
+	}
+	if c.C.Sign() <= 0 || c.C.Cmp(pk.NSquared) >= 0 {
+		return ErrCiphertextOutOfRange
+	}
+	if new(big.Int).GCD(nil, nil, c.C, pk.N).Cmp(one) != 0 {
+		return fmt.Errorf("%w: not coprime with n", ErrCiphertextOutOfRange)
+	}
+	return nil
+}
+
+// validatePlaintext checks that 0 <= m < n
+func (pk *PublicKey) validatePlaintext(m *big.Int) error {
+	if m.Sign() < 0 {
+		return fmt.Errorf("%w: negative plaintext", ErrInvalidPlaintext)
+	}
+	if m.Cmp(pk.N) >= 0 {
+		return ErrPlaintextTooLarge
+	}
+	return nil
+}
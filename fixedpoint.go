@@ -0,0 +1,339 @@
+package pailliersdk
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Fixed-point encoding of decimals, similar to EncodedNumber of python-paillier.
+// A number is represented as mantissa * base^exponent with an integer mantissa,
+// the mantissa is encrypted with the signed encoding and the exponent travels
+// in clear next to the ciphertext.
+
+// FixedPoint configures the encoding of decimals.
+type FixedPoint struct {
+	Base     int // e.g. 10 or 16
+	Exponent int // precision, numbers are rounded to multiples of base^exponent
+}
+
+// DefaultFixedPoint keeps 13 hex digits after the point, which covers the
+// 53 bit mantissa of a float64 in [1, 2).
+var DefaultFixedPoint = FixedPoint{Base: 16, Exponent: -13}
+
+// EncodedNumber is a plaintext fixed-point number Mantissa * Base^Exponent.
+type EncodedNumber struct {
+	Mantissa *big.Int
+	Base     int
+	Exponent int
+}
+
+// EncryptedNumber is an encrypted fixed-point number, the ciphertext holds
+// the mantissa and the exponent is public.
+type EncryptedNumber struct {
+	PublicKey  *PublicKey
+	Ciphertext Ciphertext
+	Base       int
+	Exponent   int
+}
+
+var (
+	errInvalidBase     = errors.New("fixed point base must be at least 2")
+	errBaseMismatch    = errors.New("fixed point numbers have different bases")
+	errInvalidExponent = errors.New("can not increase the exponent of an encoded number")
+)
+
+// EncodeFloat rounds v to a multiple of base^exponent.
+func (f FixedPoint) EncodeFloat(v float64) (*EncodedNumber, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, fmt.Errorf("%w: %v is not finite", ErrInvalidPlaintext, v)
+	}
+	return f.encodeRat(new(big.Rat).SetFloat64(v))
+}
+
+// EncodeDecimal rounds a decimal string such as "-12.345" or "1e-3" to a
+// multiple of base^exponent.
+func (f FixedPoint) EncodeDecimal(s string) (*EncodedNumber, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q is not a decimal number", ErrInvalidPlaintext, s)
+	}
+	return f.encodeRat(r)
+}
+
+func (f FixedPoint) encodeRat(r *big.Rat) (*EncodedNumber, error) {
+	if f.Base < 2 {
+		return nil, errInvalidBase
+	}
+	// mantissa = round(r / base^exponent)
+	scaled := new(big.Rat).Mul(r, ratPow(f.Base, -f.Exponent))
+	return &EncodedNumber{
+		Mantissa: roundRat(scaled),
+		Base:     f.Base,
+		Exponent: f.Exponent,
+	}, nil
+}
+
+// Rat returns the exact value of the number.
+func (e *EncodedNumber) Rat() *big.Rat {
+	r := new(big.Rat).SetInt(e.Mantissa)
+	return r.Mul(r, ratPow(e.Base, e.Exponent))
+}
+
+// Float64 returns the nearest float64 to the number.
+func (e *EncodedNumber) Float64() float64 {
+	v, _ := e.Rat().Float64()
+	return v
+}
+
+// BigFloat returns the number as a big.Float.
+func (e *EncodedNumber) BigFloat() *big.Float {
+	return new(big.Float).SetRat(e.Rat())
+}
+
+// DecimalString returns the shortest decimal which encodes back to the same
+// mantissa, e.g. "-12.345" for the encoding of -12.345 in base 16.
+func (e *EncodedNumber) DecimalString() string {
+	r := e.Rat()
+	f := FixedPoint{Base: e.Base, Exponent: e.Exponent}
+	digits := 0
+	if e.Exponent < 0 {
+		digits = int(math.Ceil(float64(-e.Exponent)*math.Log10(float64(e.Base)))) + 1
+	}
+	for k := 0; k < digits; k++ {
+		s := r.FloatString(k)
+		if d, err := f.EncodeDecimal(s); err == nil && d.Mantissa.Cmp(e.Mantissa) == 0 {
+			return s
+		}
+	}
+	return r.FloatString(digits)
+}
+
+// DecreaseExponentTo rewrites the number with a smaller exponent without
+// changing its value.
+func (e *EncodedNumber) DecreaseExponentTo(exponent int) (*EncodedNumber, error) {
+	if exponent > e.Exponent {
+		return nil, errInvalidExponent
+	}
+	factor := intPow(e.Base, e.Exponent-exponent)
+	return &EncodedNumber{
+		Mantissa: factor.Mul(factor, e.Mantissa),
+		Base:     e.Base,
+		Exponent: exponent,
+	}, nil
+}
+
+// EncryptEncoded encrypts a fixed-point number.
+func EncryptEncoded(pk *PublicKey, e *EncodedNumber) (*EncryptedNumber, error) {
+	ct, err := EncryptSigned(pk, e.Mantissa)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedNumber{PublicKey: pk, Ciphertext: ct, Base: e.Base, Exponent: e.Exponent}, nil
+}
+
+// EncryptFloat encodes and encrypts v.
+func EncryptFloat(pk *PublicKey, v float64, f FixedPoint) (*EncryptedNumber, error) {
+	e, err := f.EncodeFloat(v)
+	if err != nil {
+		return nil, err
+	}
+	return EncryptEncoded(pk, e)
+}
+
+// EncryptDecimal encodes and encrypts a decimal string.
+func EncryptDecimal(pk *PublicKey, s string, f FixedPoint) (*EncryptedNumber, error) {
+	e, err := f.EncodeDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return EncryptEncoded(pk, e)
+}
+
+// DecryptEncoded decrypts a fixed-point number.
+func DecryptEncoded(sk *PrivateKey, x *EncryptedNumber) (*EncodedNumber, error) {
+	m, err := DecryptSigned(sk, x.Ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	return &EncodedNumber{Mantissa: m, Base: x.Base, Exponent: x.Exponent}, nil
+}
+
+// DecryptFloat decrypts a fixed-point number to the nearest float64.
+func DecryptFloat(sk *PrivateKey, x *EncryptedNumber) (float64, error) {
+	e, err := DecryptEncoded(sk, x)
+	if err != nil {
+		return 0, err
+	}
+	return e.Float64(), nil
+}
+
+// DecryptBigFloat decrypts a fixed-point number to a big.Float.
+func DecryptBigFloat(sk *PrivateKey, x *EncryptedNumber) (*big.Float, error) {
+	e, err := DecryptEncoded(sk, x)
+	if err != nil {
+		return nil, err
+	}
+	return e.BigFloat(), nil
+}
+
+// DecreaseExponentTo rewrites the encrypted number with a smaller exponent,
+// the mantissa is multiplied homomorphically by base^(old-new).
+func (x *EncryptedNumber) DecreaseExponentTo(exponent int) (*EncryptedNumber, error) {
+	if exponent > x.Exponent {
+		return nil, errInvalidExponent
+	}
+	if exponent == x.Exponent {
+		return x, nil
+	}
+	factor := intPow(x.Base, x.Exponent-exponent)
+	ct, err := MulScalarSigned(x.PublicKey, x.Ciphertext, factor)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedNumber{PublicKey: x.PublicKey, Ciphertext: ct, Base: x.Base, Exponent: exponent}, nil
+}
+
+// Add returns the encrypted sum, the operand with the larger exponent is
+// rescaled first so the exponents match. Both numbers must be under the same
+// public key.
+func (x *EncryptedNumber) Add(y *EncryptedNumber) (*EncryptedNumber, error) {
+	if x.PublicKey.N.Cmp(y.PublicKey.N) != 0 {
+		return nil, fmt.Errorf("%w: numbers under different public keys", ErrInvalidPublicKey)
+	}
+	if x.Base != y.Base {
+		return nil, errBaseMismatch
+	}
+	a, b, err := alignEncrypted(x, y)
+	if err != nil {
+		return nil, err
+	}
+	ct, err := x.PublicKey.Add(a.Ciphertext, b.Ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedNumber{PublicKey: x.PublicKey, Ciphertext: ct, Base: x.Base, Exponent: a.Exponent}, nil
+}
+
+// AddPlain returns the encrypted sum with a plaintext fixed-point number.
+func (x *EncryptedNumber) AddPlain(e *EncodedNumber) (*EncryptedNumber, error) {
+	if x.Base != e.Base {
+		return nil, errBaseMismatch
+	}
+	a := x
+	var err error
+	if e.Exponent < x.Exponent {
+		if a, err = x.DecreaseExponentTo(e.Exponent); err != nil {
+			return nil, err
+		}
+	} else if e.Exponent > x.Exponent {
+		if e, err = e.DecreaseExponentTo(x.Exponent); err != nil {
+			return nil, err
+		}
+	}
+	ct, err := AddPlainSigned(x.PublicKey, a.Ciphertext, e.Mantissa)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedNumber{PublicKey: x.PublicKey, Ciphertext: ct, Base: x.Base, Exponent: a.Exponent}, nil
+}
+
+// MulScalar returns the encrypted product with a plaintext fixed-point
+// number, the exponents add up.
+func (x *EncryptedNumber) MulScalar(e *EncodedNumber) (*EncryptedNumber, error) {
+	if x.Base != e.Base {
+		return nil, errBaseMismatch
+	}
+	ct, err := MulScalarSigned(x.PublicKey, x.Ciphertext, e.Mantissa)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedNumber{PublicKey: x.PublicKey, Ciphertext: ct, Base: x.Base, Exponent: x.Exponent + e.Exponent}, nil
+}
+
+// String returns the base, the exponent and the ciphertext in hex separated
+// by ':', the exponent keeps its sign.
+func (x *EncryptedNumber) String() string {
+	return strconv.FormatInt(int64(x.Base), 16) + ":" + strconv.FormatInt(int64(x.Exponent), 16) + ":" + x.Ciphertext.String()
+}
+
+// ParseEncryptedNumber parses the String of an encrypted number under the
+// given public key.
+func ParseEncryptedNumber(pk *PublicKey, s string) (*EncryptedNumber, error) {
+	if pk == nil {
+		return nil, ErrInvalidPublicKey
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: expect base:exponent:ciphertext", ErrInvalidCiphertext)
+	}
+	base, err := strconv.ParseInt(parts[0], 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid base", ErrInvalidCiphertext)
+	}
+	exponent, err := strconv.ParseInt(parts[1], 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid exponent", ErrInvalidCiphertext)
+	}
+	if err := pk.checkFixedPoint(FixedPoint{Base: int(base), Exponent: int(exponent)}); err != nil {
+		return nil, err
+	}
+	ct, err := ParseCiphertext(pk, parts[2])
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedNumber{PublicKey: pk, Ciphertext: ct, Base: int(base), Exponent: int(exponent)}, nil
+}
+
+// checkFixedPoint rejects bases below 2 and exponents with base^|exponent|
+// beyond n, no mantissa under the key would be left to use them
+func (pk *PublicKey) checkFixedPoint(f FixedPoint) error {
+	if f.Base < 2 {
+		return errInvalidBase
+	}
+	if math.Abs(float64(f.Exponent))*math.Log2(float64(f.Base)) > float64(pk.Bits) {
+		return fmt.Errorf("%w: %d^%d exceeds the key size", ErrPlaintextTooLarge, f.Base, f.Exponent)
+	}
+	return nil
+}
+
+// alignEncrypted brings both numbers to the smaller of the two exponents
+func alignEncrypted(x, y *EncryptedNumber) (*EncryptedNumber, *EncryptedNumber, error) {
+	var err error
+	if x.Exponent > y.Exponent {
+		x, err = x.DecreaseExponentTo(y.Exponent)
+	} else if y.Exponent > x.Exponent {
+		y, err = y.DecreaseExponentTo(x.Exponent)
+	}
+	return x, y, err
+}
+
+// intPow returns base^e for e >= 0
+func intPow(base, e int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(int64(base)), big.NewInt(int64(e)), nil)
+}
+
+// ratPow returns base^e for any integer e
+func ratPow(base, e int) *big.Rat {
+	if e >= 0 {
+		return new(big.Rat).SetInt(intPow(base, e))
+	}
+	return new(big.Rat).SetFrac(one, intPow(base, -e))
+}
+
+// roundRat rounds to the nearest integer, halves away from zero
+func roundRat(r *big.Rat) *big.Int {
+	num := new(big.Int).Abs(r.Num())
+	den := r.Denom()
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Lsh(rem, 1).Cmp(den) >= 0 {
+		q.Add(q, one)
+	}
+	if r.Sign() < 0 {
+		q.Neg(q)
+	}
+	return q
+}
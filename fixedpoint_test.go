@@ -0,0 +1,90 @@
+package pailliersdk
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestFixedPoint(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+
+	price, err := EncryptFloat(pk, 12.75, DefaultFixedPoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a coarser exponent, aligned automatically on addition
+	fee, err := EncryptDecimal(pk, "-0.5", FixedPoint{Base: 16, Exponent: -2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	total, err := price.Add(fee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total.Exponent != DefaultFixedPoint.Exponent {
+		t.Fatalf("exponent %d, expect %d", total.Exponent, DefaultFixedPoint.Exponent)
+	}
+	if v, _ := DecryptFloat(sk, total); v != 12.25 {
+		t.Fatalf("decrypted %v, expect 12.25", v)
+	}
+
+	rate, _ := DefaultFixedPoint.EncodeFloat(-1.5)
+	scaled, err := total.MulScalar(rate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tax, _ := FixedPoint{Base: 16, Exponent: -1}.EncodeDecimal("0.25")
+	scaled, err = scaled.AddPlain(tax)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := DecryptBigFloat(sk, scaled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := f.Float64(); v != -18.125 {
+		t.Fatalf("decrypted %v, expect -18.125", v)
+	}
+
+	// decimals are rounded to the precision of the encoding
+	third, _ := EncryptDecimal(pk, "0.3333", FixedPoint{Base: 10, Exponent: -2})
+	if v, _ := DecryptFloat(sk, third); v != 0.33 {
+		t.Fatalf("decrypted %v, expect 0.33", v)
+	}
+	if _, err := DefaultFixedPoint.EncodeFloat(math.Inf(1)); err == nil {
+		t.Fatal("infinite float encoded")
+	}
+	if _, err := third.Add(price); err == nil {
+		t.Fatal("added numbers with different bases")
+	}
+	other, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreign := &EncryptedNumber{PublicKey: &other.PublicKey, Ciphertext: price.Ciphertext, Base: price.Base, Exponent: price.Exponent}
+	if _, err := price.Add(foreign); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Fatalf("numbers under different keys, expect ErrInvalidPublicKey, got %v", err)
+	}
+
+	// the exponent travels with the ciphertext
+	parsed, err := ParseEncryptedNumber(pk, scaled.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Base != scaled.Base || parsed.Exponent != scaled.Exponent {
+		t.Fatalf("parsed %d^%d, expect %d^%d", parsed.Base, parsed.Exponent, scaled.Base, scaled.Exponent)
+	}
+	if v, _ := DecryptFloat(sk, parsed); v != -18.125 {
+		t.Fatalf("decrypted %v, expect -18.125", v)
+	}
+	for _, s := range []string{"a:-2", "1:-2:" + third.Ciphertext.String(), "a:-7fffffff:" + third.Ciphertext.String()} {
+		if _, err := ParseEncryptedNumber(pk, s); err == nil {
+			t.Fatalf("parsed %q", s)
+		}
+	}
+}
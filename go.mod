@@ -1,6 +1,6 @@
 module github.com/hongyanwang/pailliersdk
 
-go 1.12
+go 1.17
 
 require (
 	github.com/golang/protobuf v1.3.2
@@ -0,0 +1,199 @@
+package pailliersdk
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+)
+
+var one = big.NewInt(1)
+
+// PublicKey is a parsed paillier public key. It caches n^2 and n+1 so that
+// they are not recomputed on every operation.
+type PublicKey struct {
+	Bits     int      // bit length of n, e.g. 1024
+	N        *big.Int // public modulus n = p*q
+	NSquared *big.Int // cached n^2
+	G        *big.Int // cached generator n+1
+
+	backend Backend // backend the key was generated or parsed by, nil for the default
+}
+
+// PrivateKey is a parsed paillier private key, it always carries its public key.
+type PrivateKey struct {
+	PublicKey
+	Lambda *big.Int // lcm(p-1, q-1)
+	X      *big.Int // cached L(g^lambda mod n^2)^-1 mod n
+
+	p, q *big.Int    // prime factors of n, nil for a lambda only key
+	crt  *crtValues // precomputed from p and q for decryptCRT
+}
+
+// Ciphertext is a paillier ciphertext, an element of Z*_{n^2}.
+type Ciphertext struct {
+	C    *big.Int
+	size int // serialized length in bytes, taken from the public key
+}
+
+// NewPublicKey builds a public key from the modulus n.
+func NewPublicKey(n *big.Int) *PublicKey {
+	return &PublicKey{
+		Bits:     n.BitLen(),
+		N:        new(big.Int).Set(n),
+		NSquared: new(big.Int).Mul(n, n),
+		G:        new(big.Int).Add(n, one),
+	}
+}
+
+// NewPrivateKey builds a private key from lambda and its public key.
+func NewPrivateKey(pub *PublicKey, lambda *big.Int) *PrivateKey {
+	// g = n+1, so g^lambda mod n^2 = 1 + lambda*n and L(g^lambda mod n^2) = lambda mod n
+	x := new(big.Int).Mod(lambda, pub.N)
+	x.ModInverse(x, pub.N)
+	return &PrivateKey{
+		PublicKey: *pub,
+		Lambda:    new(big.Int).Set(lambda),
+		X:         x,
+	}
+}
+
+// ParsePublicKey parses a public key in the hex format of paillier_pubkey_to_hex.
+func ParsePublicKey(s string) (*PublicKey, error) {
+	return parsePublicKey(DefaultBackend(), s)
+}
+
+// ParsePrivateKey parses a private key in the hex format of paillier_prvkey_to_hex,
+// optionally followed by ":p:q", like libpaillier the corresponding public key
+// is needed.
+func ParsePrivateKey(pub *PublicKey, s string) (*PrivateKey, error) {
+	if pub == nil {
+		return nil, ErrInvalidPublicKey
+	}
+	return parsePrivateKey(pub.Backend(), pub, s)
+}
+
+func parsePublicKey(b Backend, s string) (*PublicKey, error) {
+	pk, err := b.UnmarshalPublicKey(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkModulus(pk.N); err != nil {
+		return nil, err
+	}
+	return pk, nil
+}
+
+func parsePrivateKey(b Backend, pub *PublicKey, s string) (*PrivateKey, error) {
+	lambda, p, q, err := splitPrivateKey(s)
+	if err != nil {
+		return nil, err
+	}
+	sk, err := b.UnmarshalPrivateKey(pub, lambda)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkLambda(pub, sk.Lambda); err != nil {
+		return nil, err
+	}
+	if p != nil {
+		return sk.withPrimes(p, q)
+	}
+	return sk, nil
+}
+
+// ParseCiphertext parses a hex encoded ciphertext under the given public key,
+// the ciphertext must have the length of n^2 and lie in Z*_{n^2}.
+func ParseCiphertext(pub *PublicKey, s string) (Ciphertext, error) {
+	if pub == nil {
+		return Ciphertext{}, ErrInvalidPublicKey
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return Ciphertext{}, fmt.Errorf("%w: invalid hex", ErrInvalidCiphertext)
+	}
+	if len(b) != pub.ciphertextLen() {
+		return Ciphertext{}, fmt.Errorf("%w: expect %d bytes, got %d", ErrInvalidCiphertext, pub.ciphertextLen(), len(b))
+	}
+	c := pub.NewCiphertext(new(big.Int).SetBytes(b))
+	if err := pub.ValidateCiphertext(c); err != nil {
+		return Ciphertext{}, err
+	}
+	return c, nil
+}
+
+// String returns n in hex, the same format as paillier_pubkey_to_hex.
+func (pk *PublicKey) String() string {
+	return pk.Backend().MarshalPublicKey(pk)
+}
+
+// String returns lambda in hex, the same format as paillier_prvkey_to_hex,
+// followed by ":p:q" if the key knows the prime factors of n.
+func (sk *PrivateKey) String() string {
+	s := sk.Backend().MarshalPrivateKey(sk)
+	if sk.crt != nil {
+		s += ":" + sk.p.Text(16) + ":" + sk.q.Text(16)
+	}
+	return s
+}
+
+// Backend returns the backend operations on this key are dispatched to.
+func (pk *PublicKey) Backend() Backend {
+	if pk.backend == nil {
+		return DefaultBackend()
+	}
+	return pk.backend
+}
+
+// WithBackend returns a copy of the key bound to backend b.
+func (pk *PublicKey) WithBackend(b Backend) *PublicKey {
+	res := *pk
+	res.backend = b
+	return &res
+}
+
+// WithBackend returns a copy of the key bound to backend b.
+func (sk *PrivateKey) WithBackend(b Backend) *PrivateKey {
+	res := *sk
+	res.backend = b
+	return &res
+}
+
+// NewCiphertext wraps c as a ciphertext under this public key.
+func (pk *PublicKey) NewCiphertext(c *big.Int) Ciphertext {
+	return Ciphertext{C: c, size: pk.ciphertextLen()}
+}
+
+// ciphertextLen is the byte length of a serialized ciphertext, i.e. of n^2
+func (pk *PublicKey) ciphertextLen() int {
+	return (2*pk.Bits + 7) / 8
+}
+
+// plaintextLen is the byte length of a serialized plaintext, i.e. of n
+func (pk *PublicKey) plaintextLen() int {
+	return (pk.Bits + 7) / 8
+}
+
+// Bytes returns the big-endian ciphertext, left padded to the key's ciphertext length.
+func (c Ciphertext) Bytes() []byte {
+	return padBytes(c.C.Bytes(), c.size)
+}
+
+// String returns the hex encoded ciphertext.
+func (c Ciphertext) String() string {
+	return hex.EncodeToString(c.Bytes())
+}
+
+func isHex(s string) bool {
+	_, ok := new(big.Int).SetString(s, 16)
+	return ok
+}
+
+// padBytes left pads b with zeros to size bytes
+func padBytes(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b
+	}
+	res := make([]byte, size)
+	copy(res[size-len(b):], b)
+	return res
+}
@@ -0,0 +1,127 @@
+package pailliersdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+)
+
+// LinearCombination returns a ciphertext of sum w_i*m_i for signed public
+// weights. The product prod c_i^{w_i} is computed by simultaneous
+// exponentiation (Straus): the squarings are shared by all ciphertexts and
+// each one only costs a multiplication per window of its weight. A negative
+// weight exponentiates the inverse of the ciphertext by |w_i|, so the
+// exponents stay as short as the weights.
+func LinearCombination(pk *PublicKey, ciphers []Ciphertext, weights []*big.Int, opts ...BatchOption) (Ciphertext, error) {
+	if len(ciphers) != len(weights) {
+		return Ciphertext{}, errLengthMismatch
+	}
+	bases := make([]*big.Int, 0, len(ciphers))
+	exps := make([]*big.Int, 0, len(ciphers))
+	for i, c := range ciphers {
+		if err := pk.ValidateCiphertext(c); err != nil {
+			return Ciphertext{}, fmt.Errorf("ciphertext %d: %w", i, err)
+		}
+		if _, err := pk.EncodeSigned(weights[i]); err != nil {
+			return Ciphertext{}, fmt.Errorf("weight %d: %w", i, err)
+		}
+		switch weights[i].Sign() {
+		case 0:
+			continue
+		case 1:
+			bases = append(bases, c.C)
+		case -1:
+			bases = append(bases, new(big.Int).ModInverse(c.C, pk.NSquared))
+		}
+		exps = append(exps, new(big.Int).Abs(weights[i]))
+	}
+	if len(bases) == 0 {
+		return EncryptBig(pk, new(big.Int))
+	}
+
+	// one multi-exponentiation per worker, the partial products are summed up
+	workers := newBatchConfig(opts).workers
+	if workers > len(bases) {
+		workers = len(bases)
+	}
+	chunk := (len(bases) + workers - 1) / workers
+	parts := make([]Ciphertext, (len(bases)+chunk-1)/chunk)
+	err := runBatch(context.Background(), len(parts), workers, func(i int) error {
+		lo, hi := i*chunk, (i+1)*chunk
+		if hi > len(bases) {
+			hi = len(bases)
+		}
+		if b, ok := pk.Backend().(multiExpBackend); ok {
+			parts[i] = pk.NewCiphertext(b.MultiExp(pk, bases[lo:hi], exps[lo:hi]))
+		} else {
+			parts[i] = pk.NewCiphertext(multiExp(bases[lo:hi], exps[lo:hi], pk.NSquared))
+		}
+		return nil
+	})
+	if err != nil {
+		return Ciphertext{}, err
+	}
+	return Sum(pk, parts, opts...)
+}
+
+// multiExpBackend is implemented by backends with their own simultaneous
+// exponentiation modulo n^2
+type multiExpBackend interface {
+	MultiExp(pk *PublicKey, bases, exps []*big.Int) *big.Int
+}
+
+// multiExp returns prod bases[i]^exps[i] mod m for non-negative exponents,
+// with fixed windows shared by all bases
+func multiExp(bases, exps []*big.Int, m *big.Int) *big.Int {
+	maxBits := 0
+	for _, e := range exps {
+		if e.BitLen() > maxBits {
+			maxBits = e.BitLen()
+		}
+	}
+	w := strausWindow(maxBits)
+
+	// table[i][d] = bases[i]^d for 0 < d < 2^w
+	table := make([][]*big.Int, len(bases))
+	for i, b := range bases {
+		table[i] = make([]*big.Int, 1<<w)
+		table[i][1] = b
+		for d := 2; d < 1<<w; d++ {
+			t := new(big.Int).Mul(table[i][d-1], b)
+			table[i][d] = t.Mod(t, m)
+		}
+	}
+
+	acc := big.NewInt(1)
+	windows := (maxBits + int(w) - 1) / int(w)
+	for k := windows - 1; k >= 0; k-- {
+		for s := uint(0); s < w && k < windows-1; s++ {
+			acc.Mul(acc, acc)
+			acc.Mod(acc, m)
+		}
+		for i, e := range exps {
+			d := 0
+			for s := int(w) - 1; s >= 0; s-- {
+				d = d<<1 | int(e.Bit(k*int(w)+s))
+			}
+			if d != 0 {
+				acc.Mul(acc, table[i][d])
+				acc.Mod(acc, m)
+			}
+		}
+	}
+	return acc
+}
+
+// strausWindow trades the 2^w - 2 multiplications filling the table of a
+// base against the one multiplication per window of its exponent
+func strausWindow(bits int) uint {
+	best, bestCost := uint(1), bits
+	for w := uint(2); w <= 6; w++ {
+		cost := 1<<w - 2 + (bits+int(w)-1)/int(w)
+		if cost < bestCost {
+			best, bestCost = w, cost
+		}
+	}
+	return best
+}
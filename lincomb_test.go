@@ -0,0 +1,75 @@
+package pailliersdk
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestLinearCombination(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+	vec, err := EncryptVector(context.Background(), pk, bigInts(3, -7, 11, 5, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	big1, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	cases := []struct {
+		weights []*big.Int
+		expect  *big.Int
+	}{
+		{bigInts(1, 1, 1, 1, 1), big.NewInt(14)},
+		{bigInts(2, -3, 0, 100000, -1), big.NewInt(6 + 21 + 500000 - 2)},
+		{bigInts(0, 0, 0, 0, 0), big.NewInt(0)},
+		{[]*big.Int{big1, big.NewInt(0), big.NewInt(0), big.NewInt(0), new(big.Int).Neg(big1)}, big1},
+	}
+	for i, c := range cases {
+		for _, workers := range []int{1, 2, 8} {
+			ct, err := LinearCombination(pk, vec.Elements, c.weights, WithBatchWorkers(workers))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m, _ := DecryptSigned(sk, ct); m.Cmp(c.expect) != 0 {
+				t.Fatalf("case %d with %d workers: decrypted %s, expect %s", i, workers, m, c.expect)
+			}
+		}
+	}
+
+	if _, err := LinearCombination(pk, vec.Elements, bigInts(1)); err == nil {
+		t.Fatal("weights of the wrong length should be rejected")
+	}
+	if _, err := LinearCombination(pk, vec.Elements[:1], []*big.Int{pk.N}); !errors.Is(err, ErrPlaintextTooLarge) {
+		t.Fatalf("weight n, got %v", err)
+	}
+}
+
+func benchmarkLinComb(b *testing.B, straus bool) {
+	sk, _ := GenerateKey(2048)
+	pk := &sk.PublicKey
+	values := make([]*big.Int, 64)
+	weights := make([]*big.Int, 64)
+	for i := range values {
+		values[i] = big.NewInt(int64(i))
+		weights[i] = big.NewInt(int64(i*i*1000 + 1))
+	}
+	vec, _ := EncryptVector(context.Background(), pk, values)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if straus {
+			LinearCombination(pk, vec.Elements, weights, WithBatchWorkers(1))
+			continue
+		}
+		terms := make([]Ciphertext, len(weights))
+		for j := range terms {
+			terms[j], _ = MulScalarSigned(pk, vec.Elements[j], weights[j])
+		}
+		Sum(pk, terms, WithBatchWorkers(1))
+	}
+}
+
+func BenchmarkLinCombExp(b *testing.B)    { benchmarkLinComb(b, false) }
+func BenchmarkLinCombStraus(b *testing.B) { benchmarkLinComb(b, true) }
@@ -0,0 +1,130 @@
+package pailliersdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+)
+
+// Evaluation of a plaintext linear model on encrypted inputs, the model
+// owner computes W*x + b homomorphically and only the owner of the private
+// key learns the scores. For logistic regression the sigmoid is applied to
+// the decrypted scores.
+
+// EncryptedMatVec returns the encrypted product W*x of a plaintext matrix of
+// signed integers with an encrypted vector, row i is prod_j x_j^{W_ij}.
+func EncryptedMatVec(pk *PublicKey, W [][]*big.Int, encX []Ciphertext, opts ...BatchOption) ([]Ciphertext, error) {
+	cols := len(encX)
+	for i, row := range W {
+		if len(row) != cols {
+			return nil, fmt.Errorf("row %d: %w", i, errLengthMismatch)
+		}
+	}
+	// all the exponentiations of the matrix at once
+	terms := make([]Ciphertext, len(W)*cols)
+	err := runBatch(context.Background(), len(terms), newBatchConfig(opts).workers, func(k int) error {
+		var err error
+		terms[k], err = MulScalarSigned(pk, encX[k%cols], W[k/cols][k%cols])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Ciphertext, len(W))
+	for i := range res {
+		if res[i], err = Sum(pk, terms[i*cols:(i+1)*cols], opts...); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// AddBias adds the signed plaintext bias to the encrypted scores.
+func AddBias(pk *PublicKey, scores []Ciphertext, bias []*big.Int) ([]Ciphertext, error) {
+	if len(scores) != len(bias) {
+		return nil, errLengthMismatch
+	}
+	res := make([]Ciphertext, len(scores))
+	for i := range res {
+		var err error
+		if res[i], err = AddPlainSigned(pk, scores[i], bias[i]); err != nil {
+			return nil, fmt.Errorf("score %d: %w", i, err)
+		}
+	}
+	return res, nil
+}
+
+// LinearModel is a plaintext model scores = Weights*x + Bias, the weights
+// are encoded with FixedPoint before evaluation.
+type LinearModel struct {
+	Weights    [][]float64
+	Bias       []float64 // optional, one per row of Weights
+	FixedPoint FixedPoint
+}
+
+// Evaluate returns the encrypted scores for the encrypted fixed-point inputs.
+// The scores carry the exponent of the weights plus the one of the inputs,
+// so the plaintext space must hold the products of the mantissas.
+func (m *LinearModel) Evaluate(x []*EncryptedNumber, opts ...BatchOption) ([]*EncryptedNumber, error) {
+	if len(x) == 0 {
+		return nil, errLengthMismatch
+	}
+	pk := x[0].PublicKey
+	// bring the inputs to a common exponent
+	exponent := x[0].Exponent
+	for _, xi := range x {
+		if xi.Base != m.FixedPoint.Base {
+			return nil, errBaseMismatch
+		}
+		if xi.Exponent < exponent {
+			exponent = xi.Exponent
+		}
+	}
+	encX := make([]Ciphertext, len(x))
+	for j, xi := range x {
+		aligned, err := xi.DecreaseExponentTo(exponent)
+		if err != nil {
+			return nil, err
+		}
+		encX[j] = aligned.Ciphertext
+	}
+
+	W := make([][]*big.Int, len(m.Weights))
+	for i, row := range m.Weights {
+		W[i] = make([]*big.Int, len(row))
+		for j, w := range row {
+			e, err := m.FixedPoint.EncodeFloat(w)
+			if err != nil {
+				return nil, fmt.Errorf("weight %d,%d: %w", i, j, err)
+			}
+			W[i][j] = e.Mantissa
+		}
+	}
+	scores, err := EncryptedMatVec(pk, W, encX, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	exponent += m.FixedPoint.Exponent
+	if m.Bias != nil {
+		// the bias is encoded right at the exponent of the scores
+		scale := FixedPoint{Base: m.FixedPoint.Base, Exponent: exponent}
+		bias := make([]*big.Int, len(m.Bias))
+		for i, b := range m.Bias {
+			e, err := scale.EncodeFloat(b)
+			if err != nil {
+				return nil, fmt.Errorf("bias %d: %w", i, err)
+			}
+			bias[i] = e.Mantissa
+		}
+		if scores, err = AddBias(pk, scores, bias); err != nil {
+			return nil, err
+		}
+	}
+
+	res := make([]*EncryptedNumber, len(scores))
+	for i, c := range scores {
+		res[i] = &EncryptedNumber{PublicKey: pk, Ciphertext: c, Base: m.FixedPoint.Base, Exponent: exponent}
+	}
+	return res, nil
+}
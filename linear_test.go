@@ -0,0 +1,81 @@
+package pailliersdk
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestEncryptedMatVec(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+	encX := make([]Ciphertext, 3)
+	for j, v := range bigInts(2, -1, 5) {
+		if encX[j], err = EncryptSigned(pk, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	W := [][]*big.Int{bigInts(1, 2, 3), bigInts(-4, 0, 1)}
+	scores, err := EncryptedMatVec(pk, W, encX, WithBatchWorkers(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	scores, err = AddBias(pk, scores, bigInts(-100, 7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, expect := range []int64{2 - 2 + 15 - 100, -8 + 5 + 7} {
+		if m, _ := DecryptSigned(sk, scores[i]); m.Int64() != expect {
+			t.Fatalf("score %d is %s, expect %d", i, m, expect)
+		}
+	}
+
+	if _, err := EncryptedMatVec(pk, [][]*big.Int{bigInts(1, 2)}, encX); err == nil {
+		t.Fatal("row of the wrong length should be rejected")
+	}
+}
+
+func TestLinearModel(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+	features := []float64{0.5, -1.25, 3}
+	x := make([]*EncryptedNumber, len(features))
+	for j, v := range features {
+		if x[j], err = EncryptFloat(pk, v, DefaultFixedPoint); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// one input with a coarser exponent is aligned before evaluation
+	if x[2], err = EncryptFloat(pk, features[2], FixedPoint{Base: 16, Exponent: -2}); err != nil {
+		t.Fatal(err)
+	}
+
+	model := &LinearModel{
+		Weights:    [][]float64{{0.1, 0.2, -0.3}, {1.5, 0, 0.25}},
+		Bias:       []float64{0.05, -2},
+		FixedPoint: DefaultFixedPoint,
+	}
+	scores, err := model.Evaluate(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, row := range model.Weights {
+		expect := model.Bias[i]
+		for j, w := range row {
+			expect += w * features[j]
+		}
+		got, err := DecryptFloat(sk, scores[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(got-expect) > 1e-9 {
+			t.Fatalf("score %d is %v, expect %v", i, got, expect)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package pailliersdk
+
+import (
+	"math/big"
+)
+
+// homomorphic operations not provided by libpaillier, computed directly in Z*_{n^2}
+
+// Neg returns a ciphertext of -m mod n, the inverse of c in Z*_{n^2}.
+func (pk *PublicKey) Neg(cipher Ciphertext) (Ciphertext, error) {
+	if err := pk.ValidateCiphertext(cipher); err != nil {
+		return Ciphertext{}, err
+	}
+	return pk.NewCiphertext(new(big.Int).ModInverse(cipher.C, pk.NSquared)), nil
+}
+
+// Sub returns a ciphertext of m1 - m2 mod n.
+func (pk *PublicKey) Sub(cipher1, cipher2 Ciphertext) (Ciphertext, error) {
+	neg, err := pk.Neg(cipher2)
+	if err != nil {
+		return Ciphertext{}, err
+	}
+	return pk.Add(cipher1, neg)
+}
+
+// AddPlain returns a ciphertext of m1 + m for a public plaintext 0 <= m < n.
+// Since g = n+1, g^m mod n^2 = 1 + m*n and no fresh encryption is needed,
+// note the result shares the randomness of the input ciphertext.
+func AddPlain(pk *PublicKey, cipher Ciphertext, m *big.Int) (Ciphertext, error) {
+	if err := pk.ValidateCiphertext(cipher); err != nil {
+		return Ciphertext{}, err
+	}
+	if err := pk.validatePlaintext(m); err != nil {
+		return Ciphertext{}, err
+	}
+	gm := new(big.Int).Mul(m, pk.N)
+	gm.Add(gm, one)
+	res := gm.Mul(gm, cipher.C)
+	return pk.NewCiphertext(res.Mod(res, pk.NSquared)), nil
+}
+
+// AddPlainSigned is AddPlain for a signed public plaintext.
+func AddPlainSigned(pk *PublicKey, cipher Ciphertext, v *big.Int) (Ciphertext, error) {
+	m, err := pk.EncodeSigned(v)
+	if err != nil {
+		return Ciphertext{}, err
+	}
+	return AddPlain(pk, cipher, m)
+}
+
+// Rerandomize returns a fresh ciphertext of the same plaintext by multiplying
+// c with r^n for a new random r, i.e. with a blinded encryption of zero, so the
+// result can not be linked to the input.
+func Rerandomize(pk *PublicKey, cipher Ciphertext) (Ciphertext, error) {
+	if err := pk.ValidateCiphertext(cipher); err != nil {
+		return Ciphertext{}, err
+	}
+	zero, err := EncryptBig(pk, new(big.Int))
+	if err != nil {
+		return Ciphertext{}, err
+	}
+	return pk.Add(cipher, zero)
+}
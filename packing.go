@@ -0,0 +1,91 @@
+package pailliersdk
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Packing of several small unsigned values into one plaintext. Slot i holds
+// bits [i*w, (i+1)*w) of the plaintext with w = ValueBits + Headroom, the
+// headroom absorbs the growth of the values under homomorphic operations so
+// that slots never carry into each other: adding up to 2^Headroom packed
+// ciphertexts, or multiplying by a scalar below 2^Headroom, keeps every slot
+// exact. The packed ciphertexts are ordinary ciphertexts, Add (PaillierMul)
+// and MulScalar (PaillierExp) work on all slots at once.
+
+// Packer packs values under a public key.
+type Packer struct {
+	PublicKey *PublicKey
+	ValueBits int // width of the packed values
+	Headroom  int // extra bits per slot
+	Slots     int // number of slots fitting in a plaintext
+}
+
+var errInvalidPacker = errors.New("invalid packing, value bits must be positive and a slot must fit in the plaintext")
+
+// NewPacker fits as many slots of valueBits+headroom bits as possible below n.
+func NewPacker(pk *PublicKey, valueBits, headroom int) (*Packer, error) {
+	if valueBits < 1 || headroom < 0 {
+		return nil, errInvalidPacker
+	}
+	// n has Bits bits, every value below 2^(Bits-1) is a plaintext
+	slots := (pk.Bits - 1) / (valueBits + headroom)
+	if slots < 1 {
+		return nil, errInvalidPacker
+	}
+	return &Packer{PublicKey: pk, ValueBits: valueBits, Headroom: headroom, Slots: slots}, nil
+}
+
+func (p *Packer) slotBits() uint {
+	return uint(p.ValueBits + p.Headroom)
+}
+
+// Pack packs up to Slots values below 2^ValueBits, value i goes to slot i.
+func (p *Packer) Pack(values []*big.Int) (*big.Int, error) {
+	if len(values) > p.Slots {
+		return nil, fmt.Errorf("%w: %d values for %d slots", ErrPlaintextTooLarge, len(values), p.Slots)
+	}
+	m := new(big.Int)
+	for i := len(values) - 1; i >= 0; i-- {
+		v := values[i]
+		if v.Sign() < 0 || v.BitLen() > p.ValueBits {
+			return nil, fmt.Errorf("%w: value %d does not fit in %d bits", ErrInvalidPlaintext, i, p.ValueBits)
+		}
+		m.Lsh(m, p.slotBits())
+		m.Or(m, v)
+	}
+	return m, nil
+}
+
+// Unpack returns the Slots values of a packed plaintext, after homomorphic
+// operations they may use the headroom bits too.
+func (p *Packer) Unpack(m *big.Int) []*big.Int {
+	mask := new(big.Int).Lsh(one, p.slotBits())
+	mask.Sub(mask, one)
+	values := make([]*big.Int, p.Slots)
+	rest := new(big.Int).Set(m)
+	for i := range values {
+		values[i] = new(big.Int).And(rest, mask)
+		rest.Rsh(rest, p.slotBits())
+	}
+	return values
+}
+
+// Encrypt packs and encrypts the values.
+func (p *Packer) Encrypt(values []*big.Int) (Ciphertext, error) {
+	m, err := p.Pack(values)
+	if err != nil {
+		return Ciphertext{}, err
+	}
+	return EncryptBig(p.PublicKey, m)
+}
+
+// Decrypt decrypts and unpacks the values of all slots.
+func (p *Packer) Decrypt(sk *PrivateKey, cipher Ciphertext) ([]*big.Int, error) {
+	m, err := DecryptBig(sk, cipher)
+	if err != nil {
+		return nil, err
+	}
+	return p.Unpack(m), nil
+}
@@ -0,0 +1,68 @@
+package pailliersdk
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestPacker(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+	p, err := NewPacker(pk, 32, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Slots != (testBit-1)/40 {
+		t.Fatalf("%d slots, expect %d", p.Slots, (testBit-1)/40)
+	}
+
+	max := big.NewInt(1<<32 - 1)
+	a := make([]*big.Int, p.Slots)
+	b := make([]*big.Int, p.Slots)
+	for i := range a {
+		a[i] = big.NewInt(int64(i))
+		b[i] = new(big.Int).Sub(max, big.NewInt(int64(i)))
+	}
+	ca, err := p.Encrypt(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cb, err := p.Encrypt(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// (a + b) * 255, every slot uses its headroom fully without carrying over
+	sum, _ := pk.Add(ca, cb)
+	prod, _ := pk.MulScalar(sum, 255)
+	res, err := p.Decrypt(sk, prod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := new(big.Int).Mul(max, big.NewInt(255))
+	for i, v := range res {
+		if v.Cmp(expect) != 0 {
+			t.Fatalf("slot %d is %s, expect %s", i, v, expect)
+		}
+	}
+
+	// fewer values leave the upper slots at zero
+	ct, _ := p.Encrypt([]*big.Int{big.NewInt(7)})
+	res, _ = p.Decrypt(sk, ct)
+	if res[0].Int64() != 7 || res[1].Sign() != 0 {
+		t.Fatalf("unpacked %s %s, expect 7 0", res[0], res[1])
+	}
+
+	if _, err := p.Pack([]*big.Int{new(big.Int).Add(max, one)}); !errors.Is(err, ErrInvalidPlaintext) {
+		t.Fatalf("value above 32 bits, got %v", err)
+	}
+	if _, err := p.Pack(make([]*big.Int, p.Slots+1)); !errors.Is(err, ErrPlaintextTooLarge) {
+		t.Fatalf("too many values, got %v", err)
+	}
+	if _, err := NewPacker(pk, testBit, 0); err == nil {
+		t.Fatal("slot wider than the plaintext should be rejected")
+	}
+}
@@ -1,36 +1,57 @@
 package pailliersdk
 
-/*
-#cgo CFLAGS: -I${SRCDIR}/include
-#cgo LDFLAGS: -L${SRCDIR}/lib -lpaillier -lgmp
-
-#include "paillier.h"
-#include "gmp.h"
-#include <string.h>
-#include <stdio.h>
-#include <stdlib.h>
-*/
-import "C"
 import (
-	"encoding/hex"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/hongyanwang/pailliersdk/xchain_plugin/pb"
 	"math/big"
-	"strconv"
 	"sync"
-	"unsafe"
 )
 
-var get_rand = (*[0]byte)(unsafe.Pointer(C.paillier_get_rand_devurandom))
-var secbit = 1024
-var length = secbit/4
+type PaillierClient struct {
+	backend       Backend
+	requireProofs bool
+}
+
+// ClientOption configures a PaillierClient
+type ClientOption func(*PaillierClient)
+
+// WithBackend makes the client generate and parse keys with b, the keys in
+// turn route all arithmetic to b.
+func WithBackend(b Backend) ClientOption {
+	return func(s *PaillierClient) {
+		s.backend = b
+	}
+}
+
+// WithRequireProofs makes every method which operates on ciphertexts under
+// a commitment reject inputs without a proof of plaintext knowledge:
+// PaillierMul, PaillierExp, PaillierSub, PaillierNeg, PaillierAddPlain,
+// PaillierRerandomize, PaillierSum, PaillierLinComb and the PaillierVec
+// methods but PaillierVecEnc and PaillierVecDec. Decryption needs the private
+// key and is not gated, neither is PaillierVerifyRange. The encryption methods
+// then always return the proofs, otherwise only on request with withProof.
+func WithRequireProofs(require bool) ClientOption {
+	return func(s *PaillierClient) {
+		s.requireProofs = require
+	}
+}
 
-type PaillierClient struct {}
 var kInstance *PaillierClient
 var once sync.Once
-func NewPaillierClient() *PaillierClient {
+
+// NewPaillierClient returns the shared client on the default backend, a new
+// client is built whenever options are given.
+func NewPaillierClient(opts ...ClientOption) *PaillierClient {
+	if len(opts) > 0 {
+		s := &PaillierClient{}
+		for _, opt := range opts {
+			opt(s)
+		}
+		return s
+	}
 	if kInstance != nil {
 		return kInstance
 	}
@@ -40,6 +61,15 @@ func NewPaillierClient() *PaillierClient {
 	return kInstance
 }
 
+// Backend returns the backend the client dispatches to, the default one
+// unless set with WithBackend.
+func (s *PaillierClient) Backend() Backend {
+	if s.backend == nil {
+		return DefaultBackend()
+	}
+	return s.backend
+}
+
 func (s *PaillierClient) Submit(method string, inputs string) (string, error) {
 	if method != "paillier" {
 		return "", errors.New("submit error, wrong method, supposed to be paillier")
@@ -54,38 +84,106 @@ func (s *PaillierClient) Submit(method string, inputs string) (string, error) {
 	var resMapStr string
 	switch caller.Method {
 	case "PaillierKeyGen":
-		resMapStr, err = KeyGenToMap(caller)
+		resMapStr, err = s.KeyGenToMap(caller)
 	case "PaillierEnc":
-		resMapStr, err = PaillierEncToMap(caller)
+		resMapStr, err = s.PaillierEncToMap(caller)
 	case "PaillierDec":
-		resMapStr, err = PaillierDecToMap(caller)
+		resMapStr, err = s.PaillierDecToMap(caller)
 	case "PaillierMul":
-		resMapStr, err = PaillierMulToMap(caller)
+		resMapStr, err = s.PaillierMulToMap(caller)
 	case "PaillierExp":
-		resMapStr, err = PaillierExpToMap(caller)
+		resMapStr, err = s.PaillierExpToMap(caller)
+	case "PaillierSub":
+		resMapStr, err = s.PaillierSubToMap(caller)
+	case "PaillierNeg":
+		resMapStr, err = s.PaillierNegToMap(caller)
+	case "PaillierAddPlain":
+		resMapStr, err = s.PaillierAddPlainToMap(caller)
+	case "PaillierRerandomize":
+		resMapStr, err = s.PaillierRerandomizeToMap(caller)
+	case "PaillierEncBatch":
+		resMapStr, err = s.PaillierEncBatchToMap(caller)
+	case "PaillierDecBatch":
+		resMapStr, err = s.PaillierDecBatchToMap(caller)
+	case "PaillierEncPacked":
+		resMapStr, err = s.PaillierEncPackedToMap(caller)
+	case "PaillierDecPacked":
+		resMapStr, err = s.PaillierDecPackedToMap(caller)
+	case "PaillierVecEnc":
+		resMapStr, err = s.PaillierVecEncToMap(caller)
+	case "PaillierVecDec":
+		resMapStr, err = s.PaillierVecDecToMap(caller)
+	case "PaillierVecAdd":
+		resMapStr, err = s.PaillierVecAddToMap(caller)
+	case "PaillierVecMul":
+		resMapStr, err = s.PaillierVecMulToMap(caller)
+	case "PaillierVecDot":
+		resMapStr, err = s.PaillierVecDotToMap(caller)
+	case "PaillierVecSum":
+		resMapStr, err = s.PaillierVecSumToMap(caller)
+	case "PaillierSum":
+		resMapStr, err = s.PaillierSumToMap(caller)
+	case "PaillierLinComb":
+		resMapStr, err = s.PaillierLinCombToMap(caller)
+	case "PaillierPartialDec":
+		resMapStr, err = s.PaillierPartialDecToMap(caller)
+	case "PaillierCombine":
+		resMapStr, err = s.PaillierCombineToMap(caller)
+	case "PaillierVerifyRange":
+		resMapStr, err = s.PaillierVerifyRangeToMap(caller)
+	case "PaillierEncDecimal":
+		resMapStr, err = s.PaillierEncDecimalToMap(caller)
+	case "PaillierDecDecimal":
+		resMapStr, err = s.PaillierDecDecimalToMap(caller)
 	default:
 		return "", errors.New("submit error, invalid paillier method")
 	}
 
 	if err != nil {
-		return "", fmt.Errorf("submit error,  %v", err)
+		return "", fmt.Errorf("submit error,  %w", err)
 	}
 
 	return resMapStr,nil
 }
 
+// package level forms of the original methods, they run on the shared client
+func KeyGenToMap(caller FuncCaller) (string, error){
+	return NewPaillierClient().KeyGenToMap(caller)
+}
+
+func PaillierEncToMap(caller FuncCaller) (string, error){
+	return NewPaillierClient().PaillierEncToMap(caller)
+}
+
+func PaillierDecToMap(caller FuncCaller) (string, error){
+	return NewPaillierClient().PaillierDecToMap(caller)
+}
+
+func PaillierMulToMap(caller FuncCaller) (string, error){
+	return NewPaillierClient().PaillierMulToMap(caller)
+}
+
+func PaillierExpToMap(caller FuncCaller) (string, error){
+	return NewPaillierClient().PaillierExpToMap(caller)
+}
+
 // wrap method outputs to map
 //TODO: verify signature and commitment
-func KeyGenToMap(caller FuncCaller) (string, error){
+func (s *PaillierClient) KeyGenToMap(caller FuncCaller) (string, error){
 	if caller.Args == "" {
 		return "", errors.New("KeyGen errors, args nil")
 	}
 	var params pb.KeyGenParams
-	json.Unmarshal([]byte(caller.Args), &params)
-	prvkey, pubkey := KeyGen(int(params.Secbit))
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("KeyGen errors, unmarshal args error")
+	}
+	sk, err := generateKey(s.Backend(), int(params.Secbit))
+	if err != nil {
+		return "", fmt.Errorf("KeyGen errors, %w", err)
+	}
 	outputs := pb.KeyGenOutputs{
-		PrivateKey: prvkey,
-		PublicKey: pubkey,
+		PrivateKey: sk.String(),
+		PublicKey: sk.PublicKey.String(),
 	}
 
 	resStr,err := json.Marshal(outputs)
@@ -95,17 +193,28 @@ func KeyGenToMap(caller FuncCaller) (string, error){
 	return string(resStr), nil
 }
 
-func PaillierEncToMap(caller FuncCaller) (string, error){
+func (s *PaillierClient) PaillierEncToMap(caller FuncCaller) (string, error){
 	if caller.Args == "" {
 		return "", errors.New("PaillierEnc errors, args nil")
 	}
 	var params pb.PaillierEncParams
-	json.Unmarshal([]byte(caller.Args), &params)
-	msg,_  := strconv.Atoi(params.Message)
-	cipher := PaillierEnc(uint32(msg), params.PublicKey)
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierEnc errors, unmarshal args error")
+	}
+	msg, err := parseDecimal(params.Message)
+	if err != nil {
+		return "", fmt.Errorf("PaillierEnc errors, %w", err)
+	}
+	cipher, proof, err := s.paillierEncBig(msg, params.PublicKey, params.Signed, s.requireProofs || params.WithProof)
+	if err != nil {
+		return "", fmt.Errorf("PaillierEnc errors, %w", err)
+	}
 	outputs := pb.PaillierEncOutputs{
 		Ciphertext: cipher,
 	}
+	if proof != nil {
+		outputs.Proof = proof.String()
+	}
 
 	resStr,err := json.Marshal(outputs)
 	if err!=nil {
@@ -114,16 +223,26 @@ func PaillierEncToMap(caller FuncCaller) (string, error){
 	return string(resStr), nil
 }
 
-func PaillierDecToMap(caller FuncCaller) (string, error){
+func (s *PaillierClient) PaillierDecToMap(caller FuncCaller) (string, error){
 	if caller.Args == "" {
 		return "", errors.New("PaillierDec errors, args nil")
 	}
 	var params pb.PaillierDecParams
-	json.Unmarshal([]byte(caller.Args), &params)
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierDec errors, unmarshal args error")
+	}
 
-	plain := PaillierDec(params.Ciphertext, params.PublicKey, params. PrivateKey)
+	plain, proof, err := s.paillierDecWithProof(params.Ciphertext, params.PublicKey, params.PrivateKey, params.Signed)
+	if err != nil {
+		return "", fmt.Errorf("PaillierDec errors, %w", err)
+	}
 	outputs := pb.PaillierDecOutputs{
-		Plaintext: plain,
+		Proof:            proof.String(),
+		PlaintextDecimal: plain.String(),
+	}
+	// plaintexts beyond 64 bits are left to PlaintextDecimal
+	if plain.IsUint64() {
+		outputs.Plaintext = plain.Uint64()
 	}
 
 	resStr,err := json.Marshal(outputs)
@@ -133,12 +252,14 @@ func PaillierDecToMap(caller FuncCaller) (string, error){
 	return string(resStr), nil
 }
 
-func PaillierMulToMap(caller FuncCaller) (string, error){
+func (s *PaillierClient) PaillierMulToMap(caller FuncCaller) (string, error){
 	if caller.Args == "" {
 		return "", errors.New("PaillierMul errors, args nil")
 	}
 	var params pb.PaillierMulParams
-	json.Unmarshal([]byte(caller.Args), &params)
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierMul errors, unmarshal args error")
+	}
 
 	// authorization check
 	v := CheckCommitment(params.Ciphertext1, caller.Address, params.Commitment1)
@@ -149,8 +270,17 @@ func PaillierMulToMap(caller FuncCaller) (string, error){
 	if v != true {
 		return "", errors.New("not authorized to use ciphertext2")
 	}
+	if err := s.checkEncryptionProof(params.PublicKey, params.Ciphertext1, params.Proof1); err != nil {
+		return "", fmt.Errorf("PaillierMul errors, ciphertext1, %w", err)
+	}
+	if err := s.checkEncryptionProof(params.PublicKey, params.Ciphertext2, params.Proof2); err != nil {
+		return "", fmt.Errorf("PaillierMul errors, ciphertext2, %w", err)
+	}
 
-	cipher := PaillierMul(params.PublicKey, params.Ciphertext1, params.Ciphertext2)
+	cipher, err := s.paillierBinary(params.PublicKey, params.Ciphertext1, params.Ciphertext2, (*PublicKey).Add)
+	if err != nil {
+		return "", fmt.Errorf("PaillierMul errors, %w", err)
+	}
 	outputs := pb.PaillierMulOutputs{
 		Ciphertext: cipher,
 	}
@@ -162,21 +292,32 @@ func PaillierMulToMap(caller FuncCaller) (string, error){
 	return string(resStr), nil
 }
 
-func PaillierExpToMap(caller FuncCaller) (string, error){
+func (s *PaillierClient) PaillierExpToMap(caller FuncCaller) (string, error){
 	if caller.Args == "" {
 		return "", errors.New("PaillierExp errors, args nil")
 	}
 	var params pb.PaillierExpParams
-	json.Unmarshal([]byte(caller.Args), &params)
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierExp errors, unmarshal args error")
+	}
 
 	// authorization check
 	v := CheckCommitment(params.Ciphertext, caller.Address, params.Commitment)
 	if v != true {
 		return "", errors.New("not authorized to use ciphertext")
 	}
+	if err := s.checkEncryptionProof(params.PublicKey, params.Ciphertext, params.Proof); err != nil {
+		return "", fmt.Errorf("PaillierExp errors, %w", err)
+	}
 
-	scalarInput,_ := strconv.Atoi(params.Scalar)
-	cipher := PaillierExp(params.PublicKey, params.Ciphertext, uint32(scalarInput))
+	scalarInput, err := parseDecimal(params.Scalar)
+	if err != nil {
+		return "", fmt.Errorf("PaillierExp errors, %w", err)
+	}
+	cipher, err := s.paillierExpBig(params.PublicKey, params.Ciphertext, scalarInput, params.Signed)
+	if err != nil {
+		return "", fmt.Errorf("PaillierExp errors, %w", err)
+	}
 	outputs := pb.PaillierExpOutputs{
 		Ciphertext: cipher,
 	}
@@ -188,142 +329,1280 @@ func PaillierExpToMap(caller FuncCaller) (string, error){
 	return string(resStr), nil
 }
 
-// paillier encryption method
-/*
-void paillier_keygen(int modulusbits,
-					 paillier_pubkey_t** pub,
-					 paillier_prvkey_t** prv,
-					 paillier_get_rand_t get_rand )
- */
-func KeyGen(secbitinput int) (prv string, pub string){
-	secbit = secbitinput
-	length = secbit/4
+func (s *PaillierClient) PaillierSubToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierSub errors, args nil")
+	}
+	var params pb.PaillierSubParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierSub errors, unmarshal args error")
+	}
+
+	// authorization check
+	v := CheckCommitment(params.Ciphertext1, caller.Address, params.Commitment1)
+	if v != true {
+		return "", errors.New("not authorized to use ciphertext1")
+	}
+	v = CheckCommitment(params.Ciphertext2, caller.Address, params.Commitment2)
+	if v != true {
+		return "", errors.New("not authorized to use ciphertext2")
+	}
+	if err := s.checkEncryptionProof(params.PublicKey, params.Ciphertext1, params.Proof1); err != nil {
+		return "", fmt.Errorf("PaillierSub errors, ciphertext1, %w", err)
+	}
+	if err := s.checkEncryptionProof(params.PublicKey, params.Ciphertext2, params.Proof2); err != nil {
+		return "", fmt.Errorf("PaillierSub errors, ciphertext2, %w", err)
+	}
 
-	var pubkey_c *C.paillier_pubkey_t
-	var prvkey_c *C.paillier_prvkey_t
-	C.paillier_keygen(C.int(secbit), &pubkey_c, &prvkey_c, get_rand)
-	prvHex := C.paillier_prvkey_to_hex(prvkey_c)
-	pubHex := C.paillier_pubkey_to_hex(pubkey_c)
+	cipher, err := s.paillierBinary(params.PublicKey, params.Ciphertext1, params.Ciphertext2, (*PublicKey).Sub)
+	if err != nil {
+		return "", fmt.Errorf("PaillierSub errors, %w", err)
+	}
+	outputs := pb.PaillierSubOutputs{
+		Ciphertext: cipher,
+	}
 
-	C.paillier_freepubkey(pubkey_c)
-	C.paillier_freeprvkey(prvkey_c)
-	return C.GoString(prvHex), C.GoString(pubHex)
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierSub errors, marshal result error")
+	}
+	return string(resStr), nil
 }
 
-//paillier_ciphertext_t* paillier_enc(paillier_ciphertext_t* res,
-//									  paillier_pubkey_t* pub,
-//									  paillier_plaintext_t* pt,
-//									  paillier_get_rand_t get_rand )
-func PaillierEnc(msg uint32, pubkey string) string{
-	var pubkey_c *C.paillier_pubkey_t
-	var pt *C.paillier_plaintext_t
-	var ct *C.paillier_ciphertext_t
-	var len = C.int(length)
-
-	pubkey_c = C.paillier_pubkey_from_hex(C.CString(pubkey))
-	pt = C.paillier_plaintext_from_ui(C.ulong(msg))
-	// encrypt with pubkey
-	ct = C.paillier_enc(ct, pubkey_c, pt, get_rand)
-	// convert ciphertext to bytes
-	ctVoid := C.paillier_ciphertext_to_bytes(len, ct)
-	ctBytes := C.GoBytes(ctVoid, len)
-
-	C.paillier_freepubkey(pubkey_c)
-	C.paillier_freeplaintext(pt)
-	C.paillier_freeciphertext(ct)
-
-	return hex.EncodeToString(ctBytes)
-}
-
-//paillier_plaintext_t* paillier_dec(paillier_plaintext_t* res,
-//									 paillier_pubkey_t* pub,
-//							 		 paillier_prvkey_t* prv,
-//							 		 paillier_ciphertext_t* ct );
-func PaillierDec(cipher, pubkey, prvkey string) uint64{
-	var pubkey_c *C.paillier_pubkey_t
-	var prvkey_c *C.paillier_prvkey_t
-	var pt *C.paillier_plaintext_t
-	var ct *C.paillier_ciphertext_t
-	var len = C.int(length)
-
-	pubkey_c = C.paillier_pubkey_from_hex(C.CString(pubkey))
-	prvkey_c = C.paillier_prvkey_from_hex(C.CString(prvkey), pubkey_c)
-	ctBytes,_ := hex.DecodeString(cipher)
-	ctVoid :=  C.CBytes(ctBytes)
-	ct = C.paillier_ciphertext_from_bytes(ctVoid, len)
-	// decrypt with prvkey
-	pt = C.paillier_dec(pt, pubkey_c, prvkey_c, ct)
-
-	// convert plaintext to bytes
-	ptVoid := C.paillier_plaintext_to_bytes(len, pt)
-	ptBytes := C.GoBytes(ptVoid, len)
-
-	C.paillier_freepubkey(pubkey_c)
-	C.paillier_freeprvkey(prvkey_c)
-	C.paillier_freeplaintext(pt)
-	C.paillier_freeciphertext(ct)
-
-	ptHex := hex.EncodeToString(ptBytes)
-	ptInt,_ := new(big.Int).SetString(ptHex, 16)
-	return ptInt.Uint64()
-}
-
-//void paillier_mul(paillier_pubkey_t* pub,
-//					paillier_ciphertext_t* res,
-//					paillier_ciphertext_t* ct0,
-//					paillier_ciphertext_t* ct1 );
-func PaillierMul(pubkey, cipher1, cipher2 string) string{
-	var pubkey_c *C.paillier_pubkey_t
-	var ct1 *C.paillier_ciphertext_t
-	var ct2 *C.paillier_ciphertext_t
-	var len = C.int(length)
-
-	pubkey_c = C.paillier_pubkey_from_hex(C.CString(pubkey))
-	ct1Bytes,_ := hex.DecodeString(cipher1)
-	ct1Void :=  C.CBytes(ct1Bytes)
-	ct2Bytes,_ := hex.DecodeString(cipher2)
-	ct2Void :=  C.CBytes(ct2Bytes)
-	ct1 = C.paillier_ciphertext_from_bytes(ct1Void, len)
-	ct2 = C.paillier_ciphertext_from_bytes(ct2Void, len)
-	// multiply using pubkey and two ciphertexts
-	C.paillier_mul(pubkey_c, ct2, ct1, ct2)
-
-	// convert result ciphertext to bytes
-	resVoid := C.paillier_ciphertext_to_bytes(len, ct2)
-	resBytes := C.GoBytes(resVoid, len)
-
-	C.paillier_freepubkey(pubkey_c)
-	C.paillier_freeciphertext(ct1)
-	C.paillier_freeciphertext(ct2)
-
-	return hex.EncodeToString(resBytes)
-}
-
-//void paillier_exp(paillier_pubkey_t* pub,
-//					paillier_ciphertext_t* res,
-//					paillier_ciphertext_t* ct,
-//					paillier_plaintext_t* pt )
-func PaillierExp(pubkey, cipher string, plain uint32) string{
-	var pubkey_c *C.paillier_pubkey_t
-	var pt *C.paillier_plaintext_t
-	var ct *C.paillier_ciphertext_t
-	var len = C.int(length)
+func (s *PaillierClient) PaillierNegToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierNeg errors, args nil")
+	}
+	var params pb.PaillierNegParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierNeg errors, unmarshal args error")
+	}
+
+	// authorization check
+	v := CheckCommitment(params.Ciphertext, caller.Address, params.Commitment)
+	if v != true {
+		return "", errors.New("not authorized to use ciphertext")
+	}
+	if err := s.checkEncryptionProof(params.PublicKey, params.Ciphertext, params.Proof); err != nil {
+		return "", fmt.Errorf("PaillierNeg errors, %w", err)
+	}
+
+	cipher, err := s.paillierUnary(params.PublicKey, params.Ciphertext, (*PublicKey).Neg)
+	if err != nil {
+		return "", fmt.Errorf("PaillierNeg errors, %w", err)
+	}
+	outputs := pb.PaillierNegOutputs{
+		Ciphertext: cipher,
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierNeg errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierAddPlainToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierAddPlain errors, args nil")
+	}
+	var params pb.PaillierAddPlainParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierAddPlain errors, unmarshal args error")
+	}
+
+	// authorization check
+	v := CheckCommitment(params.Ciphertext, caller.Address, params.Commitment)
+	if v != true {
+		return "", errors.New("not authorized to use ciphertext")
+	}
+	if err := s.checkEncryptionProof(params.PublicKey, params.Ciphertext, params.Proof); err != nil {
+		return "", fmt.Errorf("PaillierAddPlain errors, %w", err)
+	}
+
+	msg, err := parseDecimal(params.Message)
+	if err != nil {
+		return "", fmt.Errorf("PaillierAddPlain errors, %w", err)
+	}
+	cipher, err := s.paillierAddPlainBig(params.PublicKey, params.Ciphertext, msg, params.Signed)
+	if err != nil {
+		return "", fmt.Errorf("PaillierAddPlain errors, %w", err)
+	}
+	outputs := pb.PaillierAddPlainOutputs{
+		Ciphertext: cipher,
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierAddPlain errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierRerandomizeToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierRerandomize errors, args nil")
+	}
+	var params pb.PaillierRerandomizeParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierRerandomize errors, unmarshal args error")
+	}
+
+	// authorization check
+	v := CheckCommitment(params.Ciphertext, caller.Address, params.Commitment)
+	if v != true {
+		return "", errors.New("not authorized to use ciphertext")
+	}
+	if err := s.checkEncryptionProof(params.PublicKey, params.Ciphertext, params.Proof); err != nil {
+		return "", fmt.Errorf("PaillierRerandomize errors, %w", err)
+	}
+
+	cipher, err := s.paillierUnary(params.PublicKey, params.Ciphertext, Rerandomize)
+	if err != nil {
+		return "", fmt.Errorf("PaillierRerandomize errors, %w", err)
+	}
+	outputs := pb.PaillierRerandomizeOutputs{
+		Ciphertext: cipher,
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierRerandomize errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierEncBatchToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierEncBatch errors, args nil")
+	}
+	var params pb.PaillierEncBatchParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierEncBatch errors, unmarshal args error")
+	}
+
+	pk, err := s.parsePublicKey(params.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierEncBatch errors, %w", err)
+	}
+	msgs := make([]*big.Int, len(params.Messages))
+	for i, m := range params.Messages {
+		msg, err := parseDecimal(m)
+		if err == nil && params.Signed {
+			msg, err = pk.EncodeSigned(msg)
+		}
+		if err != nil {
+			return "", fmt.Errorf("PaillierEncBatch errors, message %d, %w", i, err)
+		}
+		msgs[i] = msg
+	}
+	var ciphers []Ciphertext
+	var proofs []*EncryptionProof
+	if s.requireProofs || params.WithProof {
+		ciphers, proofs, err = EncryptBatchWithProofs(context.Background(), pk, msgs)
+	} else {
+		ciphers, err = EncryptBatch(context.Background(), pk, msgs)
+	}
+	if err != nil {
+		return "", fmt.Errorf("PaillierEncBatch errors, %w", err)
+	}
+	outputs := pb.PaillierEncBatchOutputs{
+		Ciphertexts: make([]string, len(ciphers)),
+		Proofs:      formatProofs(proofs),
+	}
+	for i, ct := range ciphers {
+		outputs.Ciphertexts[i] = ct.String()
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierEncBatch errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierDecBatchToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierDecBatch errors, args nil")
+	}
+	var params pb.PaillierDecBatchParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierDecBatch errors, unmarshal args error")
+	}
+
+	pk, err := s.parsePublicKey(params.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierDecBatch errors, %w", err)
+	}
+	sk, err := s.parsePrivateKey(pk, params.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierDecBatch errors, %w", err)
+	}
+	ciphers := make([]Ciphertext, len(params.Ciphertexts))
+	for i, c := range params.Ciphertexts {
+		if ciphers[i], err = ParseCiphertext(pk, c); err != nil {
+			return "", fmt.Errorf("PaillierDecBatch errors, ciphertext %d, %w", i, err)
+		}
+	}
+	plains, err := DecryptBatch(context.Background(), sk, ciphers)
+	if err != nil {
+		return "", fmt.Errorf("PaillierDecBatch errors, %w", err)
+	}
+	outputs := pb.PaillierDecBatchOutputs{
+		Plaintexts: make([]string, len(plains)),
+	}
+	for i, m := range plains {
+		if params.Signed {
+			m = pk.DecodeSigned(m)
+		}
+		outputs.Plaintexts[i] = m.String()
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierDecBatch errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierEncPackedToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierEncPacked errors, args nil")
+	}
+	var params pb.PaillierEncPackedParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierEncPacked errors, unmarshal args error")
+	}
+
+	pk, err := s.parsePublicKey(params.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierEncPacked errors, %w", err)
+	}
+	packer, err := NewPacker(pk, int(params.ValueBits), int(params.Headroom))
+	if err != nil {
+		return "", fmt.Errorf("PaillierEncPacked errors, %w", err)
+	}
+	values := make([]*big.Int, len(params.Values))
+	for i, v := range params.Values {
+		if values[i], err = parseDecimal(v); err != nil {
+			return "", fmt.Errorf("PaillierEncPacked errors, value %d, %w", i, err)
+		}
+	}
+	var cipher Ciphertext
+	var proof *EncryptionProof
+	if s.requireProofs || params.WithProof {
+		var m *big.Int
+		if m, err = packer.Pack(values); err == nil {
+			cipher, proof, err = EncryptWithProof(pk, m)
+		}
+	} else {
+		cipher, err = packer.Encrypt(values)
+	}
+	if err != nil {
+		return "", fmt.Errorf("PaillierEncPacked errors, %w", err)
+	}
+	outputs := pb.PaillierEncPackedOutputs{
+		Ciphertext: cipher.String(),
+		Slots: int32(packer.Slots),
+	}
+	if proof != nil {
+		outputs.Proof = proof.String()
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierEncPacked errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierDecPackedToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierDecPacked errors, args nil")
+	}
+	var params pb.PaillierDecPackedParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierDecPacked errors, unmarshal args error")
+	}
+
+	pk, err := s.parsePublicKey(params.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierDecPacked errors, %w", err)
+	}
+	sk, err := s.parsePrivateKey(pk, params.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierDecPacked errors, %w", err)
+	}
+	packer, err := NewPacker(pk, int(params.ValueBits), int(params.Headroom))
+	if err != nil {
+		return "", fmt.Errorf("PaillierDecPacked errors, %w", err)
+	}
+	cipher, err := ParseCiphertext(pk, params.Ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("PaillierDecPacked errors, %w", err)
+	}
+	values, err := packer.Decrypt(sk, cipher)
+	if err != nil {
+		return "", fmt.Errorf("PaillierDecPacked errors, %w", err)
+	}
+	if params.Count > 0 && int(params.Count) < len(values) {
+		values = values[:params.Count]
+	}
+	outputs := pb.PaillierDecPackedOutputs{
+		Values: make([]string, len(values)),
+	}
+	for i, v := range values {
+		outputs.Values[i] = v.String()
+	}
 
-	pubkey_c = C.paillier_pubkey_from_hex(C.CString(pubkey))
-	pt = C.paillier_plaintext_from_ui(C.ulong(plain))
-	ct1Bytes,_ := hex.DecodeString(cipher)
-	ct1Void :=  C.CBytes(ct1Bytes)
-	ct = C.paillier_ciphertext_from_bytes(ct1Void, len)
-	// multiply ciphertext by a plaintext number
-	C.paillier_exp(pubkey_c, ct, ct, pt)
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierDecPacked errors, marshal result error")
+	}
+	return string(resStr), nil
+}
 
-	// convert result ciphertext to bytes
-	resVoid := C.paillier_ciphertext_to_bytes(len, ct)
-	resBytes := C.GoBytes(resVoid, len)
+func (s *PaillierClient) PaillierVecEncToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierVecEnc errors, args nil")
+	}
+	var params pb.PaillierVecEncParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierVecEnc errors, unmarshal args error")
+	}
 
-	C.paillier_freepubkey(pubkey_c)
-	C.paillier_freeplaintext(pt)
-	C.paillier_freeciphertext(ct)
+	pk, err := s.parsePublicKey(params.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecEnc errors, %w", err)
+	}
+	values, err := parseDecimals(params.Values)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecEnc errors, %w", err)
+	}
+	vec, proofs, err := s.paillierVecEnc(pk, values, s.requireProofs || params.WithProof)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecEnc errors, %w", err)
+	}
+	outputs := pb.PaillierVecEncOutputs{
+		Vector: vec.String(),
+		Proofs: formatProofs(proofs),
+	}
 
-	return hex.EncodeToString(resBytes)
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierVecEnc errors, marshal result error")
+	}
+	return string(resStr), nil
 }
+
+func (s *PaillierClient) PaillierVecDecToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierVecDec errors, args nil")
+	}
+	var params pb.PaillierVecDecParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierVecDec errors, unmarshal args error")
+	}
+
+	pk, err := s.parsePublicKey(params.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecDec errors, %w", err)
+	}
+	sk, err := s.parsePrivateKey(pk, params.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecDec errors, %w", err)
+	}
+	vec, err := ParseEncryptedVector(pk, params.Vector)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecDec errors, %w", err)
+	}
+	values, err := vec.Decrypt(context.Background(), sk)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecDec errors, %w", err)
+	}
+	outputs := pb.PaillierVecDecOutputs{
+		Values: formatDecimals(values),
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierVecDec errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierVecAddToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierVecAdd errors, args nil")
+	}
+	var params pb.PaillierVecAddParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierVecAdd errors, unmarshal args error")
+	}
+
+	// authorization check
+	v := CheckCommitment(params.Vector1, caller.Address, params.Commitment1)
+	if v != true {
+		return "", errors.New("not authorized to use vector1")
+	}
+	v = CheckCommitment(params.Vector2, caller.Address, params.Commitment2)
+	if v != true {
+		return "", errors.New("not authorized to use vector2")
+	}
+
+	pk, vec1, err := s.parseVector(params.PublicKey, params.Vector1)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecAdd errors, %w", err)
+	}
+	vec2, err := ParseEncryptedVector(pk, params.Vector2)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecAdd errors, %w", err)
+	}
+	if err := s.checkEncryptionProofs(pk, vec1.Elements, params.Proofs1); err != nil {
+		return "", fmt.Errorf("PaillierVecAdd errors, vector1, %w", err)
+	}
+	if err := s.checkEncryptionProofs(pk, vec2.Elements, params.Proofs2); err != nil {
+		return "", fmt.Errorf("PaillierVecAdd errors, vector2, %w", err)
+	}
+	vec, err := vec1.Add(vec2)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecAdd errors, %w", err)
+	}
+	outputs := pb.PaillierVecAddOutputs{
+		Vector: vec.String(),
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierVecAdd errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierVecMulToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierVecMul errors, args nil")
+	}
+	var params pb.PaillierVecMulParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierVecMul errors, unmarshal args error")
+	}
+
+	// authorization check
+	v := CheckCommitment(params.Vector, caller.Address, params.Commitment)
+	if v != true {
+		return "", errors.New("not authorized to use vector")
+	}
+
+	pk, vec, err := s.parseVector(params.PublicKey, params.Vector)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecMul errors, %w", err)
+	}
+	if err := s.checkEncryptionProofs(pk, vec.Elements, params.Proofs); err != nil {
+		return "", fmt.Errorf("PaillierVecMul errors, %w", err)
+	}
+	scalar, err := parseDecimal(params.Scalar)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecMul errors, %w", err)
+	}
+	vec, err = vec.MulScalar(context.Background(), scalar)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecMul errors, %w", err)
+	}
+	outputs := pb.PaillierVecMulOutputs{
+		Vector: vec.String(),
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierVecMul errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierVecDotToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierVecDot errors, args nil")
+	}
+	var params pb.PaillierVecDotParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierVecDot errors, unmarshal args error")
+	}
+
+	// authorization check
+	v := CheckCommitment(params.Vector, caller.Address, params.Commitment)
+	if v != true {
+		return "", errors.New("not authorized to use vector")
+	}
+
+	pk, vec, err := s.parseVector(params.PublicKey, params.Vector)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecDot errors, %w", err)
+	}
+	if err := s.checkEncryptionProofs(pk, vec.Elements, params.Proofs); err != nil {
+		return "", fmt.Errorf("PaillierVecDot errors, %w", err)
+	}
+	weights, err := parseDecimals(params.Weights)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecDot errors, %w", err)
+	}
+	cipher, err := vec.Dot(context.Background(), weights)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecDot errors, %w", err)
+	}
+	outputs := pb.PaillierVecDotOutputs{
+		Ciphertext: cipher.String(),
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierVecDot errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierVecSumToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierVecSum errors, args nil")
+	}
+	var params pb.PaillierVecSumParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierVecSum errors, unmarshal args error")
+	}
+
+	// authorization check
+	v := CheckCommitment(params.Vector, caller.Address, params.Commitment)
+	if v != true {
+		return "", errors.New("not authorized to use vector")
+	}
+
+	pk, vec, err := s.parseVector(params.PublicKey, params.Vector)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecSum errors, %w", err)
+	}
+	if err := s.checkEncryptionProofs(pk, vec.Elements, params.Proofs); err != nil {
+		return "", fmt.Errorf("PaillierVecSum errors, %w", err)
+	}
+	cipher, err := vec.Sum()
+	if err != nil {
+		return "", fmt.Errorf("PaillierVecSum errors, %w", err)
+	}
+	outputs := pb.PaillierVecSumOutputs{
+		Ciphertext: cipher.String(),
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierVecSum errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierSumToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierSum errors, args nil")
+	}
+	var params pb.PaillierSumParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierSum errors, unmarshal args error")
+	}
+
+	// authorization check
+	if len(params.Commitments) != len(params.Ciphertexts) {
+		return "", errors.New("PaillierSum errors, expect one commitment per ciphertext")
+	}
+	for i, c := range params.Ciphertexts {
+		v := CheckCommitment(c, caller.Address, params.Commitments[i])
+		if v != true {
+			return "", fmt.Errorf("not authorized to use ciphertext %d", i)
+		}
+	}
+
+	pk, err := s.parsePublicKey(params.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierSum errors, %w", err)
+	}
+	ciphers := make([]Ciphertext, len(params.Ciphertexts))
+	for i, c := range params.Ciphertexts {
+		if ciphers[i], err = ParseCiphertext(pk, c); err != nil {
+			return "", fmt.Errorf("PaillierSum errors, ciphertext %d, %w", i, err)
+		}
+	}
+	if err := s.checkEncryptionProofs(pk, ciphers, params.Proofs); err != nil {
+		return "", fmt.Errorf("PaillierSum errors, %w", err)
+	}
+	cipher, err := Sum(pk, ciphers)
+	if err != nil {
+		return "", fmt.Errorf("PaillierSum errors, %w", err)
+	}
+	outputs := pb.PaillierSumOutputs{
+		Ciphertext: cipher.String(),
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierSum errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierLinCombToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierLinComb errors, args nil")
+	}
+	var params pb.PaillierLinCombParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierLinComb errors, unmarshal args error")
+	}
+
+	// authorization check
+	if len(params.Commitments) != len(params.Ciphertexts) {
+		return "", errors.New("PaillierLinComb errors, expect one commitment per ciphertext")
+	}
+	for i, c := range params.Ciphertexts {
+		v := CheckCommitment(c, caller.Address, params.Commitments[i])
+		if v != true {
+			return "", fmt.Errorf("not authorized to use ciphertext %d", i)
+		}
+	}
+
+	pk, err := s.parsePublicKey(params.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierLinComb errors, %w", err)
+	}
+	ciphers := make([]Ciphertext, len(params.Ciphertexts))
+	for i, c := range params.Ciphertexts {
+		if ciphers[i], err = ParseCiphertext(pk, c); err != nil {
+			return "", fmt.Errorf("PaillierLinComb errors, ciphertext %d, %w", i, err)
+		}
+	}
+	if err := s.checkEncryptionProofs(pk, ciphers, params.Proofs); err != nil {
+		return "", fmt.Errorf("PaillierLinComb errors, %w", err)
+	}
+	weights, err := parseDecimals(params.Weights)
+	if err != nil {
+		return "", fmt.Errorf("PaillierLinComb errors, weight %w", err)
+	}
+	cipher, err := LinearCombination(pk, ciphers, weights)
+	if err != nil {
+		return "", fmt.Errorf("PaillierLinComb errors, %w", err)
+	}
+	outputs := pb.PaillierLinCombOutputs{
+		Ciphertext: cipher.String(),
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierLinComb errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+// PaillierPartialDecToMap checks neither the caller nor a commitment, the key
+// share is the only credential: whoever holds it can partially decrypt any
+// ciphertext. A node has to keep its share to itself and decide on its own
+// which ciphertexts it helps to decrypt.
+func (s *PaillierClient) PaillierPartialDecToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierPartialDec errors, args nil")
+	}
+	var params pb.PaillierPartialDecParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierPartialDec errors, unmarshal args error")
+	}
+
+	tpk, err := ParseThresholdPublicKey(params.ThresholdKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierPartialDec errors, %w", err)
+	}
+	share, err := ParseKeyShare(params.KeyShare)
+	if err != nil {
+		return "", fmt.Errorf("PaillierPartialDec errors, %w", err)
+	}
+	cipher, err := ParseCiphertext(&tpk.PublicKey, params.Ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("PaillierPartialDec errors, %w", err)
+	}
+	partial, err := PartialDecrypt(tpk, share, cipher)
+	if err != nil {
+		return "", fmt.Errorf("PaillierPartialDec errors, %w", err)
+	}
+	outputs := pb.PaillierPartialDecOutputs{
+		Partial: partial.String(),
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierPartialDec errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+// PaillierCombineToMap verifies the partial decryptions, the Unverifiable keys
+// of the distributed key generation are refused.
+func (s *PaillierClient) PaillierCombineToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierCombine errors, args nil")
+	}
+	var params pb.PaillierCombineParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierCombine errors, unmarshal args error")
+	}
+
+	tpk, err := ParseThresholdPublicKey(params.ThresholdKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierCombine errors, %w", err)
+	}
+	cipher, err := ParseCiphertext(&tpk.PublicKey, params.Ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("PaillierCombine errors, %w", err)
+	}
+	partials := make([]*PartialDecryption, len(params.Partials))
+	for i, p := range params.Partials {
+		if partials[i], err = ParsePartialDecryption(p); err != nil {
+			return "", fmt.Errorf("PaillierCombine errors, partial %d, %w", i, err)
+		}
+	}
+	plain, err := Combine(tpk, cipher, partials)
+	if err != nil {
+		return "", fmt.Errorf("PaillierCombine errors, %w", err)
+	}
+	if params.Signed {
+		plain = tpk.DecodeSigned(plain)
+	}
+	outputs := pb.PaillierCombineOutputs{
+		Plaintext: plain.String(),
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierCombine errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierVerifyRangeToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierVerifyRange errors, args nil")
+	}
+	var params pb.PaillierVerifyRangeParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierVerifyRange errors, unmarshal args error")
+	}
+
+	pk, err := s.parsePublicKey(params.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVerifyRange errors, %w", err)
+	}
+	cipher, err := ParseCiphertext(pk, params.Ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVerifyRange errors, %w", err)
+	}
+	proof, err := ParseRangeProof(pk, params.Proof)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVerifyRange errors, %w", err)
+	}
+	// a well formed proof which does not verify is not an error
+	valid, err := CheckRange(pk, cipher, int(params.Bits), proof)
+	if err != nil {
+		return "", fmt.Errorf("PaillierVerifyRange errors, %w", err)
+	}
+	outputs := pb.PaillierVerifyRangeOutputs{
+		Valid: valid,
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierVerifyRange errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierEncDecimalToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierEncDecimal errors, args nil")
+	}
+	var params pb.PaillierEncDecimalParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierEncDecimal errors, unmarshal args error")
+	}
+
+	pk, err := s.parsePublicKey(params.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierEncDecimal errors, %w", err)
+	}
+	f := DefaultFixedPoint
+	if params.Base != 0 {
+		f = FixedPoint{Base: int(params.Base), Exponent: int(params.Exponent)}
+	}
+	if err := pk.checkFixedPoint(f); err != nil {
+		return "", fmt.Errorf("PaillierEncDecimal errors, %w", err)
+	}
+	number, err := EncryptDecimal(pk, params.Value, f)
+	if err != nil {
+		return "", fmt.Errorf("PaillierEncDecimal errors, %w", err)
+	}
+	outputs := pb.PaillierEncDecimalOutputs{
+		Number: number.String(),
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierEncDecimal errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+func (s *PaillierClient) PaillierDecDecimalToMap(caller FuncCaller) (string, error){
+	if caller.Args == "" {
+		return "", errors.New("PaillierDecDecimal errors, args nil")
+	}
+	var params pb.PaillierDecDecimalParams
+	if err := json.Unmarshal([]byte(caller.Args), &params); err != nil {
+		return "", errors.New("PaillierDecDecimal errors, unmarshal args error")
+	}
+
+	pk, err := s.parsePublicKey(params.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierDecDecimal errors, %w", err)
+	}
+	sk, err := s.parsePrivateKey(pk, params.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("PaillierDecDecimal errors, %w", err)
+	}
+	number, err := ParseEncryptedNumber(pk, params.Number)
+	if err != nil {
+		return "", fmt.Errorf("PaillierDecDecimal errors, %w", err)
+	}
+	value, err := DecryptEncoded(sk, number)
+	if err != nil {
+		return "", fmt.Errorf("PaillierDecDecimal errors, %w", err)
+	}
+	outputs := pb.PaillierDecDecimalOutputs{
+		Value: value.DecimalString(),
+	}
+
+	resStr,err := json.Marshal(outputs)
+	if err!=nil {
+		return "", errors.New("PaillierDecDecimal errors, marshal result error")
+	}
+	return string(resStr), nil
+}
+
+// string based methods, thin adapters of the typed ones above
+func KeyGen(secbitinput int) (prv string, pub string){
+	prv, pub, _ = KeyGenChecked(secbitinput)
+	return prv, pub
+}
+
+func PaillierEnc(msg uint32, pubkey string) string{
+	cipher, _ := PaillierEncChecked(msg, pubkey)
+	return cipher
+}
+
+func PaillierDec(cipher, pubkey, prvkey string) uint64{
+	plain, _ := PaillierDecChecked(cipher, pubkey, prvkey)
+	return plain
+}
+
+func PaillierMul(pubkey, cipher1, cipher2 string) string{
+	cipher, _ := PaillierMulChecked(pubkey, cipher1, cipher2)
+	return cipher
+}
+
+func PaillierExp(pubkey, cipher string, plain uint32) string{
+	res, _ := PaillierExpChecked(pubkey, cipher, plain)
+	return res
+}
+
+// checked variants of the string based methods, keys and ciphertexts are
+// validated before reaching libpaillier
+func KeyGenChecked(secbit int) (prv string, pub string, err error) {
+	sk, err := GenerateKey(secbit)
+	if err != nil {
+		return "", "", err
+	}
+	return sk.String(), sk.PublicKey.String(), nil
+}
+
+func PaillierEncChecked(msg uint32, pubkey string) (string, error) {
+	pk, err := ParsePublicKey(pubkey)
+	if err != nil {
+		return "", err
+	}
+	ct, err := pk.Encrypt(uint64(msg))
+	if err != nil {
+		return "", err
+	}
+	return ct.String(), nil
+}
+
+func PaillierDecChecked(cipher, pubkey, prvkey string) (uint64, error) {
+	pk, err := ParsePublicKey(pubkey)
+	if err != nil {
+		return 0, err
+	}
+	sk, err := ParsePrivateKey(pk, prvkey)
+	if err != nil {
+		return 0, err
+	}
+	ct, err := ParseCiphertext(pk, cipher)
+	if err != nil {
+		return 0, err
+	}
+	return sk.Decrypt(ct)
+}
+
+func PaillierMulChecked(pubkey, cipher1, cipher2 string) (string, error) {
+	pk, err := ParsePublicKey(pubkey)
+	if err != nil {
+		return "", err
+	}
+	ct1, err := ParseCiphertext(pk, cipher1)
+	if err != nil {
+		return "", err
+	}
+	ct2, err := ParseCiphertext(pk, cipher2)
+	if err != nil {
+		return "", err
+	}
+	res, err := pk.Add(ct1, ct2)
+	if err != nil {
+		return "", err
+	}
+	return res.String(), nil
+}
+
+func PaillierExpChecked(pubkey, cipher string, plain uint32) (string, error) {
+	pk, err := ParsePublicKey(pubkey)
+	if err != nil {
+		return "", err
+	}
+	ct, err := ParseCiphertext(pk, cipher)
+	if err != nil {
+		return "", err
+	}
+	res, err := pk.MulScalar(ct, uint64(plain))
+	if err != nil {
+		return "", err
+	}
+	return res.String(), nil
+}
+
+// PaillierSub returns a ciphertext of the difference of the two plaintexts.
+func PaillierSub(pubkey, cipher1, cipher2 string) (string, error) {
+	pk, err := ParsePublicKey(pubkey)
+	if err != nil {
+		return "", err
+	}
+	ct1, err := ParseCiphertext(pk, cipher1)
+	if err != nil {
+		return "", err
+	}
+	ct2, err := ParseCiphertext(pk, cipher2)
+	if err != nil {
+		return "", err
+	}
+	res, err := pk.Sub(ct1, ct2)
+	if err != nil {
+		return "", err
+	}
+	return res.String(), nil
+}
+
+// PaillierNeg returns a ciphertext of the negated plaintext.
+func PaillierNeg(pubkey, cipher string) (string, error) {
+	pk, err := ParsePublicKey(pubkey)
+	if err != nil {
+		return "", err
+	}
+	ct, err := ParseCiphertext(pk, cipher)
+	if err != nil {
+		return "", err
+	}
+	res, err := pk.Neg(ct)
+	if err != nil {
+		return "", err
+	}
+	return res.String(), nil
+}
+
+// PaillierRerandomize returns a fresh ciphertext of the same plaintext.
+func PaillierRerandomize(pubkey, cipher string) (string, error) {
+	pk, err := ParsePublicKey(pubkey)
+	if err != nil {
+		return "", err
+	}
+	ct, err := ParseCiphertext(pk, cipher)
+	if err != nil {
+		return "", err
+	}
+	res, err := Rerandomize(pk, ct)
+	if err != nil {
+		return "", err
+	}
+	return res.String(), nil
+}
+
+// string based methods over the full plaintext space, used by Submit,
+// signed selects the signed integer encoding
+func (s *PaillierClient) paillierEncBig(msg *big.Int, pubkey string, signed, withProof bool) (string, *EncryptionProof, error) {
+	pk, err := s.parsePublicKey(pubkey)
+	if err != nil {
+		return "", nil, err
+	}
+	if signed {
+		if msg, err = pk.EncodeSigned(msg); err != nil {
+			return "", nil, err
+		}
+	}
+	if !withProof {
+		ct, err := EncryptBig(pk, msg)
+		if err != nil {
+			return "", nil, err
+		}
+		return ct.String(), nil, nil
+	}
+	ct, proof, err := EncryptWithProof(pk, msg)
+	if err != nil {
+		return "", nil, err
+	}
+	return ct.String(), proof, nil
+}
+
+// checkEncryptionProof verifies the proof of plaintext knowledge of a
+// ciphertext, a missing proof is only accepted if the client does not
+// require proofs
+func (s *PaillierClient) checkEncryptionProof(pubkey, cipher, proof string) error {
+	if proof == "" {
+		if s.requireProofs {
+			return fmt.Errorf("%w: proof of plaintext knowledge required", ErrInvalidProof)
+		}
+		return nil
+	}
+	pk, err := s.parsePublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	ct, err := ParseCiphertext(pk, cipher)
+	if err != nil {
+		return err
+	}
+	p, err := ParseEncryptionProof(proof)
+	if err != nil {
+		return err
+	}
+	return VerifyEncryption(pk, ct, p)
+}
+
+// checkEncryptionProofs verifies one proof of plaintext knowledge per
+// ciphertext of a list or vector, no proofs at all are only accepted if the
+// client does not require proofs
+func (s *PaillierClient) checkEncryptionProofs(pk *PublicKey, ciphers []Ciphertext, proofs []string) error {
+	if len(proofs) == 0 && !s.requireProofs {
+		return nil
+	}
+	if len(proofs) != len(ciphers) {
+		return fmt.Errorf("%w: expect one proof of plaintext knowledge per ciphertext", ErrInvalidProof)
+	}
+	for i, proof := range proofs {
+		p, err := ParseEncryptionProof(proof)
+		if err == nil {
+			err = VerifyEncryption(pk, ciphers[i], p)
+		}
+		if err != nil {
+			return fmt.Errorf("ciphertext %d, %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (s *PaillierClient) paillierDecWithProof(cipher, pubkey, prvkey string, signed bool) (*big.Int, *DecryptionProof, error) {
+	pk, err := s.parsePublicKey(pubkey)
+	if err != nil {
+		return nil, nil, err
+	}
+	sk, err := s.parsePrivateKey(pk, prvkey)
+	if err != nil {
+		return nil, nil, err
+	}
+	ct, err := ParseCiphertext(pk, cipher)
+	if err != nil {
+		return nil, nil, err
+	}
+	m, proof, err := DecryptWithProof(sk, ct)
+	if err != nil {
+		return nil, nil, err
+	}
+	if signed {
+		m = sk.DecodeSigned(m)
+	}
+	return m, proof, nil
+}
+
+func (s *PaillierClient) paillierExpBig(pubkey, cipher string, scalar *big.Int, signed bool) (string, error) {
+	pk, err := s.parsePublicKey(pubkey)
+	if err != nil {
+		return "", err
+	}
+	ct, err := ParseCiphertext(pk, cipher)
+	if err != nil {
+		return "", err
+	}
+	var res Ciphertext
+	if signed {
+		res, err = MulScalarSigned(pk, ct, scalar)
+	} else {
+		res, err = MulScalarBig(pk, ct, scalar)
+	}
+	if err != nil {
+		return "", err
+	}
+	return res.String(), nil
+}
+
+func (s *PaillierClient) paillierAddPlainBig(pubkey, cipher string, msg *big.Int, signed bool) (string, error) {
+	pk, err := s.parsePublicKey(pubkey)
+	if err != nil {
+		return "", err
+	}
+	ct, err := ParseCiphertext(pk, cipher)
+	if err != nil {
+		return "", err
+	}
+	var res Ciphertext
+	if signed {
+		res, err = AddPlainSigned(pk, ct, msg)
+	} else {
+		res, err = AddPlain(pk, ct, msg)
+	}
+	if err != nil {
+		return "", err
+	}
+	return res.String(), nil
+}
+
+// paillierUnary applies op to a single ciphertext
+func (s *PaillierClient) paillierUnary(pubkey, cipher string, op func(*PublicKey, Ciphertext) (Ciphertext, error)) (string, error) {
+	pk, err := s.parsePublicKey(pubkey)
+	if err != nil {
+		return "", err
+	}
+	ct, err := ParseCiphertext(pk, cipher)
+	if err != nil {
+		return "", err
+	}
+	res, err := op(pk, ct)
+	if err != nil {
+		return "", err
+	}
+	return res.String(), nil
+}
+
+// paillierBinary applies op to a pair of ciphertexts
+func (s *PaillierClient) paillierBinary(pubkey, cipher1, cipher2 string, op func(*PublicKey, Ciphertext, Ciphertext) (Ciphertext, error)) (string, error) {
+	pk, err := s.parsePublicKey(pubkey)
+	if err != nil {
+		return "", err
+	}
+	ct1, err := ParseCiphertext(pk, cipher1)
+	if err != nil {
+		return "", err
+	}
+	ct2, err := ParseCiphertext(pk, cipher2)
+	if err != nil {
+		return "", err
+	}
+	res, err := op(pk, ct1, ct2)
+	if err != nil {
+		return "", err
+	}
+	return res.String(), nil
+}
+
+// paillierVecEnc encrypts the signed values to a vector, with one proof of
+// plaintext knowledge per element if withProof is set
+func (s *PaillierClient) paillierVecEnc(pk *PublicKey, values []*big.Int, withProof bool) (*EncryptedVector, []*EncryptionProof, error) {
+	if !withProof {
+		vec, err := EncryptVector(context.Background(), pk, values)
+		return vec, nil, err
+	}
+	msgs := make([]*big.Int, len(values))
+	for i, v := range values {
+		m, err := pk.EncodeSigned(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("value %d: %w", i, err)
+		}
+		msgs[i] = m
+	}
+	elems, proofs, err := EncryptBatchWithProofs(context.Background(), pk, msgs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &EncryptedVector{PublicKey: pk, Elements: elems}, proofs, nil
+}
+
+// formatProofs returns the Strings of the proofs, nil for no proofs
+func formatProofs(proofs []*EncryptionProof) []string {
+	if len(proofs) == 0 {
+		return nil
+	}
+	res := make([]string, len(proofs))
+	for i, p := range proofs {
+		res[i] = p.String()
+	}
+	return res
+}
+
+// parseVector parses a public key with the client backend and a vector under it
+func (s *PaillierClient) parseVector(pubkey, vector string) (*PublicKey, *EncryptedVector, error) {
+	pk, err := s.parsePublicKey(pubkey)
+	if err != nil {
+		return nil, nil, err
+	}
+	vec, err := ParseEncryptedVector(pk, vector)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pk, vec, nil
+}
+
+// parsePublicKey parses a public key with the client backend
+func (s *PaillierClient) parsePublicKey(pubkey string) (*PublicKey, error) {
+	return parsePublicKey(s.Backend(), pubkey)
+}
+
+// parsePrivateKey parses a private key with the client backend
+func (s *PaillierClient) parsePrivateKey(pk *PublicKey, prvkey string) (*PrivateKey, error) {
+	return parsePrivateKey(s.Backend(), pk, prvkey)
+}
+
+// parseDecimal parses a decimal plaintext or scalar from Submit params
+func parseDecimal(s string) (*big.Int, error) {
+	m, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q is not a decimal number", ErrInvalidPlaintext, s)
+	}
+	return m, nil
+}
+
+// parseDecimals parses repeated decimal params
+func parseDecimals(ss []string) ([]*big.Int, error) {
+	res := make([]*big.Int, len(ss))
+	for i, v := range ss {
+		m, err := parseDecimal(v)
+		if err != nil {
+			return nil, fmt.Errorf("item %d, %w", i, err)
+		}
+		res[i] = m
+	}
+	return res, nil
+}
+
+func formatDecimals(values []*big.Int) []string {
+	res := make([]string, len(values))
+	for i, v := range values {
+		res[i] = v.String()
+	}
+	return res
+}
\ No newline at end of file
@@ -4,8 +4,12 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hongyanwang/pailliersdk/xchain_plugin/pb"
 	"math/big"
 	"strconv"
+	"sync"
 	"testing"
 )
 
@@ -47,6 +51,31 @@ func getPrivateKey() *ecdsa.PrivateKey {
 	}
 }
 
+// submitCall calls method through the client c on behalf of address
+func submitCall(c *PaillierClient, method, address string, args interface{}) (string, error) {
+	data,_ := json.Marshal(args)
+	caller := &FuncCaller{
+		Method:  method,
+		Args:    string(data),
+		Address: address,
+	}
+	data,_ = json.Marshal(caller)
+	return c.Submit("paillier", string(data))
+}
+
+// submitTo calls method through the client c and unmarshals the result into
+// outputs
+func submitTo(t *testing.T, c *PaillierClient, method, address string, args interface{}, outputs interface{}) {
+	t.Helper()
+	result, err := submitCall(c, method, address, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(result), outputs); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // test paillier client method
 func TestKeyGen(t *testing.T) {
 	keyGenData := map[string]int{
@@ -157,13 +186,40 @@ func TestDec(t *testing.T) {
 	t.Log(result)
 
 	// get plaintext
-	var resMap map[string]uint64
+	var resMap pb.PaillierDecOutputs
 	err = json.Unmarshal([]byte(result), &resMap)
 	if err != nil {
 		t.Fatal(err)
 	}
-	plain := resMap["plaintext"]
+	plain := resMap.Plaintext
 	t.Logf("decrypted ciphertext1: %d\n", plain)
+	if plain != uint64(plaintext1) || resMap.PlaintextDecimal != strconv.Itoa(plaintext1) {
+		t.Fatalf("decrypted %d and %s, expect %d", plain, resMap.PlaintextDecimal, plaintext1)
+	}
+
+	// anyone holding the public key checks the plaintext with the proof
+	pk, _ := ParsePublicKey(pubkey)
+	c, _ := ParseCiphertext(pk, ciphertext1)
+	proof, err := ParseDecryptionProof(resMap.Proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyDecryption(pk, c, big.NewInt(int64(plaintext1)), proof); err != nil {
+		t.Fatal(err)
+	}
+
+	// a plaintext beyond 64 bits is only returned in decimal
+	large, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	c, _ = EncryptBig(pk, large)
+	var largeMap pb.PaillierDecOutputs
+	submitTo(t, client, "PaillierDec", owner, map[string]string{
+		"ciphertext": c.String(),
+		"publicKey": pubkey,
+		"privateKey": prvkey,
+	}, &largeMap)
+	if largeMap.Plaintext != 0 || largeMap.PlaintextDecimal != large.String() {
+		t.Fatalf("decrypted %d and %s, expect 0 and %s", largeMap.Plaintext, largeMap.PlaintextDecimal, large)
+	}
 }
 
 func TestMul(t *testing.T) {
@@ -237,3 +293,1109 @@ func TestExp(t *testing.T) {
 	expRes :=  PaillierDec(cipherExp, pubkey, prvkey)
 	t.Logf("decrypted cipherExp: %d\n", expRes)
 }
+
+func TestSub(t *testing.T) {
+	subData := map[string]string{
+		"publicKey": pubkey,
+		"ciphertext1": ciphertext2,
+		"commitment1": commitment2,
+		"ciphertext2": ciphertext1,
+		"commitment2": commitment1,
+	}
+	data,_ := json.Marshal(subData)
+	caller := &FuncCaller{
+		Method:  "PaillierSub",
+		Args:    string(data),
+		Address: user,
+	}
+	data,_ = json.Marshal(caller)
+	// call paillier and subtract ciphertext1 from ciphertext2
+	result, err := client.Submit("paillier", string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(result)
+
+	var resMap map[string]string
+	err = json.Unmarshal([]byte(result), &resMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subRes := PaillierDec(resMap["ciphertext"], pubkey, prvkey)
+	t.Logf("decrypted cipherSub: %d\n", subRes)
+	if subRes != uint64(plaintext2-plaintext1) {
+		t.Fatalf("decrypted %d, expect %d", subRes, plaintext2-plaintext1)
+	}
+
+	// a commitment for another ciphertext is rejected
+	subData["commitment2"] = commitment2
+	data,_ = json.Marshal(subData)
+	caller.Args = string(data)
+	data,_ = json.Marshal(caller)
+	if _, err := client.Submit("paillier", string(data)); err == nil {
+		t.Fatal("unauthorized ciphertext accepted")
+	}
+}
+
+func TestNeg(t *testing.T) {
+	negData := map[string]string{
+		"publicKey": pubkey,
+		"ciphertext": ciphertext1,
+		"commitment": commitment1,
+	}
+	data,_ := json.Marshal(negData)
+	caller := &FuncCaller{
+		Method:  "PaillierNeg",
+		Args:    string(data),
+		Address: user,
+	}
+	data,_ = json.Marshal(caller)
+	// call paillier and negate ciphertext1
+	result, err := client.Submit("paillier", string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(result)
+
+	var resMap map[string]string
+	err = json.Unmarshal([]byte(result), &resMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	negRes, _, err := client.paillierDecWithProof(resMap["ciphertext"], pubkey, prvkey, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("decrypted cipherNeg: %s\n", negRes)
+	if negRes.Int64() != int64(-plaintext1) {
+		t.Fatalf("decrypted %s, expect %d", negRes, -plaintext1)
+	}
+}
+
+func TestAddPlain(t *testing.T) {
+	addData := map[string]string{
+		"publicKey": pubkey,
+		"ciphertext": ciphertext1,
+		"commitment": commitment1,
+		"message": strconv.Itoa(plaintext2),
+	}
+	data,_ := json.Marshal(addData)
+	caller := &FuncCaller{
+		Method:  "PaillierAddPlain",
+		Args:    string(data),
+		Address: user,
+	}
+	data,_ = json.Marshal(caller)
+	// call paillier and add plaintext2 to ciphertext1
+	result, err := client.Submit("paillier", string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(result)
+
+	var resMap map[string]string
+	err = json.Unmarshal([]byte(result), &resMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addRes := PaillierDec(resMap["ciphertext"], pubkey, prvkey)
+	t.Logf("decrypted cipherAddPlain: %d\n", addRes)
+	if addRes != uint64(plaintext1+plaintext2) {
+		t.Fatalf("decrypted %d, expect %d", addRes, plaintext1+plaintext2)
+	}
+
+	// subtract a public constant with the signed flag
+	pk, _ := ParsePublicKey(pubkey)
+	ct, _ := ParseCiphertext(pk, ciphertext1)
+	res, err := AddPlainSigned(pk, ct, big.NewInt(-int64(plaintext2)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subRes, _, _ := client.paillierDecWithProof(res.String(), pubkey, prvkey, true)
+	if subRes.Int64() != int64(plaintext1-plaintext2) {
+		t.Fatalf("decrypted %s, expect %d", subRes, plaintext1-plaintext2)
+	}
+}
+
+func TestRerandomize(t *testing.T) {
+	reData := map[string]string{
+		"publicKey": pubkey,
+		"ciphertext": ciphertext1,
+		"commitment": commitment1,
+	}
+	data,_ := json.Marshal(reData)
+	caller := &FuncCaller{
+		Method:  "PaillierRerandomize",
+		Args:    string(data),
+		Address: user,
+	}
+	data,_ = json.Marshal(caller)
+	// call paillier and rerandomize ciphertext1
+	result, err := client.Submit("paillier", string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(result)
+
+	var resMap map[string]string
+	err = json.Unmarshal([]byte(result), &resMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cipherRe := resMap["ciphertext"]
+	if cipherRe == ciphertext1 {
+		t.Fatal("rerandomized ciphertext equals the input")
+	}
+	reRes := PaillierDec(cipherRe, pubkey, prvkey)
+	t.Logf("decrypted cipherRerandomize: %d\n", reRes)
+	if reRes != uint64(plaintext1) {
+		t.Fatalf("decrypted %d, expect %d", reRes, plaintext1)
+	}
+}
+
+func TestBatchSubmit(t *testing.T) {
+	encData := map[string]interface{}{
+		"publicKey": pubkey,
+		"messages":  []string{"1", "-2", strconv.Itoa(plaintext1)},
+		"signed":    true,
+	}
+	data,_ := json.Marshal(encData)
+	caller := &FuncCaller{
+		Method:  "PaillierEncBatch",
+		Args:    string(data),
+		Address: owner,
+	}
+	data,_ = json.Marshal(caller)
+	result, err := client.Submit("paillier", string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var encRes pb.PaillierEncBatchOutputs
+	if err := json.Unmarshal([]byte(result), &encRes); err != nil {
+		t.Fatal(err)
+	}
+	if len(encRes.Ciphertexts) != 3 {
+		t.Fatalf("got %d ciphertexts, expect 3", len(encRes.Ciphertexts))
+	}
+
+	decData := map[string]interface{}{
+		"publicKey":   pubkey,
+		"privateKey":  prvkey,
+		"ciphertexts": encRes.Ciphertexts,
+		"signed":      true,
+	}
+	data,_ = json.Marshal(decData)
+	caller.Method = "PaillierDecBatch"
+	caller.Args = string(data)
+	data,_ = json.Marshal(caller)
+	result, err = client.Submit("paillier", string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decRes pb.PaillierDecBatchOutputs
+	if err := json.Unmarshal([]byte(result), &decRes); err != nil {
+		t.Fatal(err)
+	}
+	for i, expect := range encData["messages"].([]string) {
+		if decRes.Plaintexts[i] != expect {
+			t.Fatalf("item %d decrypted %s, expect %s", i, decRes.Plaintexts[i], expect)
+		}
+	}
+
+	// a malformed ciphertext is reported by its index
+	decData["ciphertexts"] = []string{encRes.Ciphertexts[0], "zz"}
+	data,_ = json.Marshal(decData)
+	caller.Args = string(data)
+	data,_ = json.Marshal(caller)
+	if _, err := client.Submit("paillier", string(data)); !errors.Is(err, ErrInvalidCiphertext) {
+		t.Fatalf("expect ErrInvalidCiphertext, got %v", err)
+	}
+}
+
+func TestPackedSubmit(t *testing.T) {
+
+	var enc1, enc2 pb.PaillierEncPackedOutputs
+	submitTo(t, client, "PaillierEncPacked", owner, map[string]interface{}{
+		"publicKey": pubkey,
+		"values":    []string{"1", "2", "3"},
+		"valueBits": 32,
+		"headroom":  8,
+	}, &enc1)
+	submitTo(t, client, "PaillierEncPacked", owner, map[string]interface{}{
+		"publicKey": pubkey,
+		"values":    []string{"10", "20", "4294967295"},
+		"valueBits": 32,
+		"headroom":  8,
+	}, &enc2)
+
+	// slot-wise addition with PaillierMul
+	ecdsaPrvkey := getPrivateKey()
+	var mul pb.PaillierMulOutputs
+	submitTo(t, client, "PaillierMul", user, map[string]string{
+		"publicKey": pubkey,
+		"ciphertext1": enc1.Ciphertext,
+		"commitment1": Commit(ecdsaPrvkey, enc1.Ciphertext, user),
+		"ciphertext2": enc2.Ciphertext,
+		"commitment2": Commit(ecdsaPrvkey, enc2.Ciphertext, user),
+	}, &mul)
+	// slot-wise scaling with PaillierExp
+	var exp pb.PaillierExpOutputs
+	submitTo(t, client, "PaillierExp", user, map[string]string{
+		"publicKey": pubkey,
+		"ciphertext": mul.Ciphertext,
+		"commitment": Commit(ecdsaPrvkey, mul.Ciphertext, user),
+		"scalar": strconv.Itoa(scaler),
+	}, &exp)
+
+	var dec pb.PaillierDecPackedOutputs
+	submitTo(t, client, "PaillierDecPacked", owner, map[string]interface{}{
+		"publicKey":  pubkey,
+		"privateKey": prvkey,
+		"ciphertext": exp.Ciphertext,
+		"valueBits":  32,
+		"headroom":   8,
+		"count":      4,
+	}, &dec)
+	expect := []string{"22", "44", "8589934596", "0"}
+	if len(dec.Values) != len(expect) {
+		t.Fatalf("got %d values, expect %d", len(dec.Values), len(expect))
+	}
+	for i := range expect {
+		if dec.Values[i] != expect[i] {
+			t.Fatalf("slot %d is %s, expect %s", i, dec.Values[i], expect[i])
+		}
+	}
+}
+
+func TestVectorSubmit(t *testing.T) {
+	ecdsaPrvkey := getPrivateKey()
+
+	var enc1, enc2 pb.PaillierVecEncOutputs
+	submitTo(t, client, "PaillierVecEnc", owner, map[string]interface{}{
+		"publicKey": pubkey,
+		"values":    []string{"1", "-2", "3"},
+	}, &enc1)
+	submitTo(t, client, "PaillierVecEnc", owner, map[string]interface{}{
+		"publicKey": pubkey,
+		"values":    []string{"5", "5", "-5"},
+	}, &enc2)
+
+	var add pb.PaillierVecAddOutputs
+	submitTo(t, client, "PaillierVecAdd", user, map[string]string{
+		"publicKey": pubkey,
+		"vector1": enc1.Vector,
+		"commitment1": Commit(ecdsaPrvkey, enc1.Vector, user),
+		"vector2": enc2.Vector,
+		"commitment2": Commit(ecdsaPrvkey, enc2.Vector, user),
+	}, &add)
+	var mul pb.PaillierVecMulOutputs
+	submitTo(t, client, "PaillierVecMul", user, map[string]string{
+		"publicKey": pubkey,
+		"vector": add.Vector,
+		"commitment": Commit(ecdsaPrvkey, add.Vector, user),
+		"scalar": "-2",
+	}, &mul)
+	var dec pb.PaillierVecDecOutputs
+	submitTo(t, client, "PaillierVecDec", owner, map[string]string{
+		"publicKey": pubkey,
+		"privateKey": prvkey,
+		"vector": mul.Vector,
+	}, &dec)
+	expect := []string{"-12", "-6", "4"}
+	for i := range expect {
+		if dec.Values[i] != expect[i] {
+			t.Fatalf("element %d is %s, expect %s", i, dec.Values[i], expect[i])
+		}
+	}
+
+	var dot pb.PaillierVecDotOutputs
+	submitTo(t, client, "PaillierVecDot", user, map[string]interface{}{
+		"publicKey": pubkey,
+		"vector": enc1.Vector,
+		"commitment": Commit(ecdsaPrvkey, enc1.Vector, user),
+		"weights": []string{"3", "1", "-1"},
+	}, &dot)
+	var sum pb.PaillierVecSumOutputs
+	submitTo(t, client, "PaillierVecSum", user, map[string]string{
+		"publicKey": pubkey,
+		"vector": enc1.Vector,
+		"commitment": Commit(ecdsaPrvkey, enc1.Vector, user),
+	}, &sum)
+	for _, c := range []struct{ cipher string; expect int64 }{{dot.Ciphertext, -2}, {sum.Ciphertext, 2}} {
+		res, _, err := client.paillierDecWithProof(c.cipher, pubkey, prvkey, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Int64() != c.expect {
+			t.Fatalf("decrypted %s, expect %d", res, c.expect)
+		}
+	}
+
+	// vectors need a commitment to the caller
+	data,_ := json.Marshal(map[string]string{"publicKey": pubkey, "vector": enc1.Vector})
+	data,_ = json.Marshal(&FuncCaller{Method: "PaillierVecSum", Args: string(data), Address: user})
+	if _, err := client.Submit("paillier", string(data)); err == nil {
+		t.Fatal("missing commitment should be rejected")
+	}
+}
+
+func TestSumSubmit(t *testing.T) {
+	ecdsaPrvkey := getPrivateKey()
+	ciphers := []string{ciphertext1, ciphertext2, ciphertext1}
+	commitments := make([]string, len(ciphers))
+	for i, c := range ciphers {
+		commitments[i] = Commit(ecdsaPrvkey, c, user)
+	}
+	sumData := map[string]interface{}{
+		"publicKey": pubkey,
+		"ciphertexts": ciphers,
+		"commitments": commitments,
+	}
+	data,_ := json.Marshal(sumData)
+	caller := &FuncCaller{
+		Method:  "PaillierSum",
+		Args:    string(data),
+		Address: user,
+	}
+	data,_ = json.Marshal(caller)
+	result, err := client.Submit("paillier", string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resMap map[string]string
+	if err := json.Unmarshal([]byte(result), &resMap); err != nil {
+		t.Fatal(err)
+	}
+	sumRes := PaillierDec(resMap["ciphertext"], pubkey, prvkey)
+	if sumRes != uint64(2*plaintext1+plaintext2) {
+		t.Fatalf("decrypted %d, expect %d", sumRes, 2*plaintext1+plaintext2)
+	}
+
+	// every ciphertext needs its own commitment
+	sumData["commitments"] = commitments[:2]
+	data,_ = json.Marshal(sumData)
+	caller.Args = string(data)
+	data,_ = json.Marshal(caller)
+	if _, err := client.Submit("paillier", string(data)); err == nil {
+		t.Fatal("missing commitment should be rejected")
+	}
+	sumData["commitments"] = []string{commitments[0], commitments[0], commitments[2]}
+	data,_ = json.Marshal(sumData)
+	caller.Args = string(data)
+	data,_ = json.Marshal(caller)
+	if _, err := client.Submit("paillier", string(data)); err == nil {
+		t.Fatal("commitment to another ciphertext should be rejected")
+	}
+}
+
+func TestLinCombSubmit(t *testing.T) {
+	ecdsaPrvkey := getPrivateKey()
+	ciphers := []string{ciphertext1, ciphertext2}
+	linData := map[string]interface{}{
+		"publicKey": pubkey,
+		"ciphertexts": ciphers,
+		"commitments": []string{Commit(ecdsaPrvkey, ciphertext1, user), Commit(ecdsaPrvkey, ciphertext2, user)},
+		"weights": []string{"3", "-2"},
+	}
+	data,_ := json.Marshal(linData)
+	caller := &FuncCaller{
+		Method:  "PaillierLinComb",
+		Args:    string(data),
+		Address: user,
+	}
+	data,_ = json.Marshal(caller)
+	result, err := client.Submit("paillier", string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resMap map[string]string
+	if err := json.Unmarshal([]byte(result), &resMap); err != nil {
+		t.Fatal(err)
+	}
+	linRes, _, err := client.paillierDecWithProof(resMap["ciphertext"], pubkey, prvkey, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if linRes.Int64() != int64(3*plaintext1-2*plaintext2) {
+		t.Fatalf("decrypted %s, expect %d", linRes, 3*plaintext1-2*plaintext2)
+	}
+
+	// the commitments are checked per ciphertext
+	linData["commitments"] = []string{Commit(ecdsaPrvkey, ciphertext1, user), Commit(ecdsaPrvkey, ciphertext1, user)}
+	data,_ = json.Marshal(linData)
+	caller.Args = string(data)
+	data,_ = json.Marshal(caller)
+	if _, err := client.Submit("paillier", string(data)); err == nil {
+		t.Fatal("commitment to another ciphertext should be rejected")
+	}
+}
+
+func TestThresholdSubmit(t *testing.T) {
+	tpk, shares, err := GenerateThresholdKey(512, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, _ := EncryptSigned(&tpk.PublicKey, big.NewInt(int64(-plaintext1)))
+
+	// every node contributes a partial decryption with its own share
+	var partials []string
+	for _, share := range shares[1:] {
+		decData := map[string]interface{}{
+			"thresholdKey": tpk.String(),
+			"keyShare": share.String(),
+			"ciphertext": c.String(),
+		}
+		data,_ := json.Marshal(decData)
+		caller := &FuncCaller{
+			Method:  "PaillierPartialDec",
+			Args:    string(data),
+			Address: user,
+		}
+		data,_ = json.Marshal(caller)
+		result, err := client.Submit("paillier", string(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var resMap map[string]string
+		if err := json.Unmarshal([]byte(result), &resMap); err != nil {
+			t.Fatal(err)
+		}
+		partials = append(partials, resMap["partial"])
+	}
+
+	combData := map[string]interface{}{
+		"thresholdKey": tpk.String(),
+		"ciphertext": c.String(),
+		"partials": partials,
+		"signed": true,
+	}
+	data,_ := json.Marshal(combData)
+	caller := &FuncCaller{
+		Method:  "PaillierCombine",
+		Args:    string(data),
+		Address: user,
+	}
+	data,_ = json.Marshal(caller)
+	result, err := client.Submit("paillier", string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resMap map[string]string
+	if err := json.Unmarshal([]byte(result), &resMap); err != nil {
+		t.Fatal(err)
+	}
+	if resMap["plaintext"] != strconv.Itoa(-plaintext1) {
+		t.Fatalf("decrypted %s, expect %d", resMap["plaintext"], -plaintext1)
+	}
+
+	// a single partial decryption is below the threshold
+	combData["partials"] = partials[:1]
+	data,_ = json.Marshal(combData)
+	caller.Args = string(data)
+	data,_ = json.Marshal(caller)
+	if _, err := client.Submit("paillier", string(data)); err == nil {
+		t.Fatal("combining less than t partial decryptions should fail")
+	}
+}
+
+func TestRequireProofsSubmit(t *testing.T) {
+	// proofs are built on request or if the client requires them
+	strict := NewPaillierClient(WithRequireProofs(true))
+	var enc1, enc2, enc pb.PaillierEncOutputs
+	submitTo(t, client, "PaillierEnc", owner, map[string]interface{}{"publicKey": pubkey, "message": "3", "withProof": true}, &enc1)
+	submitTo(t, strict, "PaillierEnc", owner, map[string]string{"publicKey": pubkey, "message": "4"}, &enc2)
+	if enc1.Proof == "" || enc2.Proof == "" {
+		t.Fatal("PaillierEnc should return a proof")
+	}
+	if submitTo(t, client, "PaillierEnc", owner, map[string]string{"publicKey": pubkey, "message": "5"}, &enc); enc.Proof != "" {
+		t.Fatal("PaillierEnc should not build an unrequested proof")
+	}
+
+	ecdsaPrvkey := getPrivateKey()
+	mulData := map[string]string{
+		"publicKey": pubkey,
+		"ciphertext1": enc1.Ciphertext,
+		"ciphertext2": enc2.Ciphertext,
+		"commitment1": Commit(ecdsaPrvkey, enc1.Ciphertext, user),
+		"commitment2": Commit(ecdsaPrvkey, enc2.Ciphertext, user),
+		"proof1": enc1.Proof,
+		"proof2": enc2.Proof,
+	}
+	var mul pb.PaillierMulOutputs
+	submitTo(t, strict, "PaillierMul", user, mulData, &mul)
+	if res, _, _ := client.paillierDecWithProof(mul.Ciphertext, pubkey, prvkey, false); res.Int64() != 7 {
+		t.Fatalf("decrypted %s, expect 7", res)
+	}
+
+	// a proof of another ciphertext is rejected by every client, a missing
+	// one only by the strict client
+	mulData["proof2"] = enc1.Proof
+	if _, err := submitCall(client, "PaillierMul", user, mulData); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+	delete(mulData, "proof2")
+	if _, err := submitCall(client, "PaillierMul", user, mulData); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := submitCall(strict, "PaillierMul", user, mulData); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+
+	expData := map[string]string{
+		"publicKey": pubkey,
+		"ciphertext": enc1.Ciphertext,
+		"commitment": Commit(ecdsaPrvkey, enc1.Ciphertext, user),
+		"scalar": "5",
+	}
+	if _, err := submitCall(strict, "PaillierExp", user, expData); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+	expData["proof"] = enc1.Proof
+	var exp pb.PaillierExpOutputs
+	submitTo(t, strict, "PaillierExp", user, expData, &exp)
+	if res, _, _ := client.paillierDecWithProof(exp.Ciphertext, pubkey, prvkey, false); res.Int64() != 15 {
+		t.Fatalf("decrypted %s, expect 15", res)
+	}
+}
+
+// every method taking ciphertexts under a commitment is gated, decryption is not
+func TestRequireProofsGating(t *testing.T) {
+	strict := NewPaillierClient(WithRequireProofs(true))
+	call := func(method string, args map[string]interface{}) error {
+		_, err := submitCall(strict, method, user, args)
+		return err
+	}
+	pk, err := client.parsePublicKey(pubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct, proof, err := EncryptWithProof(pk, big.NewInt(6))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the batch encryptions return one proof per ciphertext on request
+	var batch pb.PaillierEncBatchOutputs
+	submitTo(t, client, "PaillierEncBatch", user, map[string]interface{}{
+		"publicKey": pubkey, "messages": []string{"1", "2"}, "withProof": true,
+	}, &batch)
+	batchCiphers := make([]Ciphertext, len(batch.Ciphertexts))
+	for i, c := range batch.Ciphertexts {
+		batchCiphers[i], _ = ParseCiphertext(pk, c)
+	}
+	if err := strict.checkEncryptionProofs(pk, batchCiphers, batch.Proofs); err != nil {
+		t.Fatal(err)
+	}
+	var vec pb.PaillierVecEncOutputs
+	submitTo(t, strict, "PaillierVecEnc", user, map[string]interface{}{
+		"publicKey": pubkey, "values": []string{"1", "-2"},
+	}, &vec)
+
+	ecdsaPrvkey := getPrivateKey()
+	cipher, vector, proofs := ct.String(), vec.Vector, vec.Proofs
+	commitment, vecCommitment := Commit(ecdsaPrvkey, cipher, user), Commit(ecdsaPrvkey, vector, user)
+
+	tests := []struct {
+		method string
+		args   map[string]interface{}
+		proofs map[string]interface{}
+	}{
+		{"PaillierSub", map[string]interface{}{"ciphertext1": cipher, "commitment1": commitment, "ciphertext2": cipher, "commitment2": commitment},
+			map[string]interface{}{"proof1": proof.String(), "proof2": proof.String()}},
+		{"PaillierNeg", map[string]interface{}{"ciphertext": cipher, "commitment": commitment},
+			map[string]interface{}{"proof": proof.String()}},
+		{"PaillierAddPlain", map[string]interface{}{"ciphertext": cipher, "commitment": commitment, "message": "1"},
+			map[string]interface{}{"proof": proof.String()}},
+		{"PaillierRerandomize", map[string]interface{}{"ciphertext": cipher, "commitment": commitment},
+			map[string]interface{}{"proof": proof.String()}},
+		{"PaillierSum", map[string]interface{}{"ciphertexts": []string{cipher}, "commitments": []string{commitment}},
+			map[string]interface{}{"proofs": []string{proof.String()}}},
+		{"PaillierLinComb", map[string]interface{}{"ciphertexts": []string{cipher}, "commitments": []string{commitment}, "weights": []string{"2"}},
+			map[string]interface{}{"proofs": []string{proof.String()}}},
+		{"PaillierVecAdd", map[string]interface{}{"vector1": vector, "commitment1": vecCommitment, "vector2": vector, "commitment2": vecCommitment},
+			map[string]interface{}{"proofs1": proofs, "proofs2": proofs}},
+		{"PaillierVecMul", map[string]interface{}{"vector": vector, "commitment": vecCommitment, "scalar": "3"},
+			map[string]interface{}{"proofs": proofs}},
+		{"PaillierVecDot", map[string]interface{}{"vector": vector, "commitment": vecCommitment, "weights": []string{"1", "1"}},
+			map[string]interface{}{"proofs": proofs}},
+		{"PaillierVecSum", map[string]interface{}{"vector": vector, "commitment": vecCommitment},
+			map[string]interface{}{"proofs": proofs}},
+	}
+	for _, test := range tests {
+		test.args["publicKey"] = pubkey
+		if err := call(test.method, test.args); !errors.Is(err, ErrInvalidProof) {
+			t.Fatalf("%s without proofs, expect ErrInvalidProof, got %v", test.method, err)
+		}
+		for k, v := range test.proofs {
+			test.args[k] = v
+		}
+		if err := call(test.method, test.args); err != nil {
+			t.Fatalf("%s with proofs, %v", test.method, err)
+		}
+	}
+
+	// a proof per element is expected
+	err = call("PaillierVecSum", map[string]interface{}{
+		"publicKey": pubkey, "vector": vector, "commitment": vecCommitment, "proofs": proofs[:1],
+	})
+	if !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+	// decryption is not gated
+	err = call("PaillierDec", map[string]interface{}{"publicKey": pubkey, "privateKey": prvkey, "ciphertext": cipher})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVerifyRangeSubmit(t *testing.T) {
+	pk, _ := ParsePublicKey(pubkey)
+	vote, proof, err := EncryptWithRangeProof(pk, big.NewInt(1), 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rangeData := map[string]interface{}{
+		"publicKey": pubkey,
+		"ciphertext": vote.String(),
+		"bits": 1,
+		"proof": proof.String(),
+	}
+	data,_ := json.Marshal(rangeData)
+	caller := &FuncCaller{
+		Method:  "PaillierVerifyRange",
+		Args:    string(data),
+		Address: user,
+	}
+	data,_ = json.Marshal(caller)
+	result, err := client.Submit("paillier", string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resMap map[string]bool
+	if err := json.Unmarshal([]byte(result), &resMap); err != nil {
+		t.Fatal(err)
+	}
+	if !resMap["valid"] {
+		t.Fatalf("expect a valid proof, got %s", result)
+	}
+
+	// a ballot of 15 is rejected before aggregation
+	rangeData["ciphertext"] = ciphertext1
+	data,_ = json.Marshal(rangeData)
+	caller.Args = string(data)
+	data,_ = json.Marshal(caller)
+	result, err = client.Submit("paillier", string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resMap = nil
+	if err := json.Unmarshal([]byte(result), &resMap); err != nil {
+		t.Fatal(err)
+	}
+	if valid, ok := resMap["valid"]; !ok || valid {
+		t.Fatalf("expect valid false, got %s", result)
+	}
+
+	// a malformed proof fails the call
+	rangeData["proof"] = "1:2:3"
+	data,_ = json.Marshal(rangeData)
+	caller.Args = string(data)
+	data,_ = json.Marshal(caller)
+	if _, err := client.Submit("paillier", string(data)); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+}
+
+// the package level functions run on the shared client
+func TestPackageToMap(t *testing.T) {
+	data,_ := json.Marshal(map[string]string{"publicKey": pubkey, "message": "9"})
+	result, err := PaillierEncToMap(FuncCaller{Method: "PaillierEnc", Args: string(data), Address: owner})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var enc pb.PaillierEncOutputs
+	if err := json.Unmarshal([]byte(result), &enc); err != nil {
+		t.Fatal(err)
+	}
+	data,_ = json.Marshal(map[string]string{"publicKey": pubkey, "privateKey": prvkey, "ciphertext": enc.Ciphertext})
+	result, err = PaillierDecToMap(FuncCaller{Method: "PaillierDec", Args: string(data), Address: owner})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dec map[string]interface{}
+	if err := json.Unmarshal([]byte(result), &dec); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(dec["plaintext"]) != "9" {
+		t.Fatalf("decrypted %v, expect 9", dec["plaintext"])
+	}
+}
+
+func TestDecimalSubmit(t *testing.T) {
+	for _, test := range []struct {
+		value          string
+		base, exponent int
+		expect         string
+	}{
+		{"-12.345", 0, 0, "-12.345"},
+		{"0.3333", 10, -2, "0.33"},
+		{"1e-3", 10, -5, "0.001"},
+		{"42", 2, 0, "42"},
+	} {
+		var enc pb.PaillierEncDecimalOutputs
+		var dec pb.PaillierDecDecimalOutputs
+		submitTo(t, client, "PaillierEncDecimal", owner, map[string]interface{}{
+			"publicKey": pubkey,
+			"value": test.value,
+			"base": test.base,
+			"exponent": test.exponent,
+		}, &enc)
+		submitTo(t, client, "PaillierDecDecimal", owner, map[string]string{
+			"publicKey": pubkey,
+			"privateKey": prvkey,
+			"number": enc.Number,
+		}, &dec)
+		if dec.Value != test.expect {
+			t.Fatalf("%s: decrypted %s, expect %s", test.value, dec.Value, test.expect)
+		}
+	}
+}
+
+func TestTypedKeys(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+
+	// keys round trip through their hex form
+	pk2, err := ParsePublicKey(pk.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sk2, err := ParsePrivateKey(pk2, sk.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c1, err := pk.Encrypt(uint64(plaintext1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c2, err := pk.Encrypt(uint64(plaintext2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, err := pk.Add(c1, c2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prod, err := pk.MulScalar(c1, uint64(scaler))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ciphertexts round trip through their hex form
+	parsed, err := ParseCiphertext(pk2, sum.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, _ := sk2.Decrypt(parsed); res != uint64(plaintext1+plaintext2) {
+		t.Fatalf("decrypted sum %d, expect %d", res, plaintext1+plaintext2)
+	}
+	if res, _ := sk.Decrypt(prod); res != uint64(plaintext1*scaler) {
+		t.Fatalf("decrypted product %d, expect %d", res, plaintext1*scaler)
+	}
+
+	// typed and string based methods are interchangeable
+	if res := PaillierDec(c1.String(), pk.String(), sk.String()); res != uint64(plaintext1) {
+		t.Fatalf("decrypted ciphertext %d, expect %d", res, plaintext1)
+	}
+}
+
+func TestCheckedErrors(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+	cipher, err := PaillierEncChecked(uint32(plaintext1), pk.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	zero := pk.NewCiphertext(big.NewInt(0)).String()
+	notCoprime := pk.NewCiphertext(pk.N).String()
+
+	if _, _, err := KeyGenChecked(1023); !errors.Is(err, ErrInvalidKeySize) {
+		t.Fatalf("odd key size, got %v", err)
+	}
+	if _, err := PaillierEncChecked(1, "not hex"); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Fatalf("invalid public key, got %v", err)
+	}
+	if _, err := PaillierDecChecked(cipher, pk.String(), "zz"); !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Fatalf("invalid private key, got %v", err)
+	}
+	if _, err := PaillierMulChecked(pk.String(), cipher[2:], cipher); !errors.Is(err, ErrInvalidCiphertext) {
+		t.Fatalf("short ciphertext, got %v", err)
+	}
+	if _, err := PaillierExpChecked(pk.String(), zero, 2); !errors.Is(err, ErrCiphertextOutOfRange) {
+		t.Fatalf("zero ciphertext, got %v", err)
+	}
+	if _, err := PaillierDecChecked(notCoprime, pk.String(), sk.String()); !errors.Is(err, ErrCiphertextOutOfRange) {
+		t.Fatalf("ciphertext not coprime with n, got %v", err)
+	}
+	if CheckCommitment(cipher, user, "bad") {
+		t.Fatal("malformed commitment accepted")
+	}
+
+	// errors surface through Submit
+	data, _ := json.Marshal(map[string]string{
+		"ciphertext": zero,
+		"publicKey":  pk.String(),
+		"privateKey": sk.String(),
+	})
+	data, _ = json.Marshal(&FuncCaller{Method: "PaillierDec", Args: string(data), Address: owner})
+	if _, err := client.Submit("paillier", string(data)); !errors.Is(err, ErrCiphertextOutOfRange) {
+		t.Fatalf("submit with zero ciphertext, got %v", err)
+	}
+}
+
+func TestBigPlaintext(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+
+	// a plaintext far beyond 64 bits
+	msg, _ := new(big.Int).SetString("123456789012345678901234567890123456789", 10)
+	data, _ := json.Marshal(map[string]string{
+		"message":   msg.String(),
+		"publicKey": pk.String(),
+	})
+	data, _ = json.Marshal(&FuncCaller{Method: "PaillierEnc", Args: string(data), Address: owner})
+	result, err := client.Submit("paillier", string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resMap map[string]string
+	if err := json.Unmarshal([]byte(result), &resMap); err != nil {
+		t.Fatal(err)
+	}
+	ct, err := ParseCiphertext(pk, resMap["ciphertext"])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scalar, _ := new(big.Int).SetString("98765432109876543210", 10)
+	prod, err := MulScalarBig(pk, ct, scalar)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain, err := DecryptBig(sk, prod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := new(big.Int).Mul(msg, scalar)
+	if plain.Cmp(expect) != 0 {
+		t.Fatalf("decrypted %s, expect %s", plain, expect)
+	}
+
+	if _, err := EncryptBig(pk, pk.N); !errors.Is(err, ErrPlaintextTooLarge) {
+		t.Fatalf("plaintext n, got %v", err)
+	}
+}
+
+func TestSigned(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+
+	// encrypt a debit through Submit
+	data, _ := json.Marshal(map[string]interface{}{
+		"message":   "-5",
+		"publicKey": pk.String(),
+		"signed":    true,
+	})
+	data, _ = json.Marshal(&FuncCaller{Method: "PaillierEnc", Args: string(data), Address: owner})
+	result, err := client.Submit("paillier", string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resMap map[string]string
+	if err := json.Unmarshal([]byte(result), &resMap); err != nil {
+		t.Fatal(err)
+	}
+	debit, err := ParseCiphertext(pk, resMap["ciphertext"])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	credit, err := EncryptInt64(pk, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, err := pk.Add(debit, credit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := DecryptInt64(sk, sum); v != -2 {
+		t.Fatalf("decrypted %d, expect -2", v)
+	}
+	prod, err := MulScalarSigned(pk, credit, big.NewInt(-3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// decrypt the signed product through Submit
+	data, _ = json.Marshal(map[string]interface{}{
+		"ciphertext": prod.String(),
+		"publicKey":  pk.String(),
+		"privateKey": sk.String(),
+		"signed":     true,
+	})
+	data, _ = json.Marshal(&FuncCaller{Method: "PaillierDec", Args: string(data), Address: owner})
+	result, err = client.Submit("paillier", string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(result), &resMap); err != nil {
+		t.Fatal(err)
+	}
+	if resMap["plaintextDecimal"] != "-9" {
+		t.Fatalf("decrypted %s, expect -9", resMap["plaintextDecimal"])
+	}
+
+	// without the flag negative messages are rejected
+	if _, _, err := client.paillierEncBig(big.NewInt(-1), pk.String(), false, false); !errors.Is(err, ErrInvalidPlaintext) {
+		t.Fatalf("unsigned negative message, got %v", err)
+	}
+}
+
+// countingBackend records the operations reaching the wrapped backend
+type countingBackend struct {
+	Backend
+	calls map[string]int
+}
+
+func (b *countingBackend) KeyGen(bits int) (*PrivateKey, error) {
+	b.calls["KeyGen"]++
+	sk, err := b.Backend.KeyGen(bits)
+	if err != nil {
+		return nil, err
+	}
+	return sk.WithBackend(b), nil
+}
+
+func (b *countingBackend) Encrypt(pk *PublicKey, msg *big.Int) (Ciphertext, error) {
+	b.calls["Encrypt"]++
+	return b.Backend.Encrypt(pk, msg)
+}
+
+func (b *countingBackend) Decrypt(sk *PrivateKey, cipher Ciphertext) (*big.Int, error) {
+	b.calls["Decrypt"]++
+	return b.Backend.Decrypt(sk, cipher)
+}
+
+func (b *countingBackend) UnmarshalPublicKey(s string) (*PublicKey, error) {
+	pk, err := b.Backend.UnmarshalPublicKey(s)
+	if err != nil {
+		return nil, err
+	}
+	return pk.WithBackend(b), nil
+}
+
+func (b *countingBackend) UnmarshalPrivateKey(pk *PublicKey, s string) (*PrivateKey, error) {
+	sk, err := b.Backend.UnmarshalPrivateKey(pk, s)
+	if err != nil {
+		return nil, err
+	}
+	return sk.WithBackend(b), nil
+}
+
+func TestClientBackend(t *testing.T) {
+	goBackend, ok := LookupBackend("go")
+	if !ok {
+		t.Fatal("go backend not registered")
+	}
+	b := &countingBackend{Backend: goBackend, calls: make(map[string]int)}
+	c := NewPaillierClient(WithBackend(b))
+	if c == NewPaillierClient() || c.Backend() != b {
+		t.Fatal("options should build a new client on the given backend")
+	}
+
+	var keys pb.KeyGenOutputs
+	var enc pb.PaillierEncOutputs
+	var dec pb.PaillierDecOutputs
+	submitTo(t, c, "PaillierKeyGen", owner, map[string]int{"secbit": 512}, &keys)
+	submitTo(t, c, "PaillierEnc", owner, map[string]string{"publicKey": keys.PublicKey, "message": "42"}, &enc)
+	submitTo(t, c, "PaillierDec", owner, map[string]string{
+		"publicKey":  keys.PublicKey,
+		"privateKey": keys.PrivateKey,
+		"ciphertext": enc.Ciphertext,
+	}, &dec)
+	if dec.Plaintext != 42 {
+		t.Fatalf("decrypted %d, expect 42", dec.Plaintext)
+	}
+	for _, op := range []string{"KeyGen", "Encrypt", "Decrypt"} {
+		if b.calls[op] != 1 {
+			t.Fatalf("%s reached the backend %d times, expect 1", op, b.calls[op])
+		}
+	}
+}
+
+// keys of different sizes are served side by side from several goroutines
+func TestMultipleKeySizes(t *testing.T) {
+	sizes := []int{1024, 2048, 3072}
+	if testing.Short() {
+		sizes = []int{512, 1024}
+	}
+	type keyPair struct{ prv, pub string }
+	keys := make(map[int]keyPair)
+	for _, bits := range sizes {
+		prv, pub, err := KeyGenChecked(bits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[bits] = keyPair{prv, pub}
+	}
+
+	errs := make(chan error, 4*len(sizes))
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		for bits, key := range keys {
+			wg.Add(1)
+			go func(bits int, key keyPair) {
+				defer wg.Done()
+				c1, _ := PaillierEncChecked(uint32(plaintext1), key.pub)
+				c2, _ := PaillierEncChecked(uint32(plaintext2), key.pub)
+				sum, _ := PaillierMulChecked(key.pub, c1, c2)
+				prod, err := PaillierExpChecked(key.pub, sum, uint32(scaler))
+				if err != nil {
+					errs <- fmt.Errorf("%d bits: %w", bits, err)
+					return
+				}
+				// hex of n^2 takes bits/2 characters
+				if len(prod) != bits/2 {
+					errs <- fmt.Errorf("%d bits: ciphertext of %d hex characters, expect %d", bits, len(prod), bits/2)
+					return
+				}
+				res, err := PaillierDecChecked(prod, key.pub, key.prv)
+				if err != nil {
+					errs <- fmt.Errorf("%d bits: %w", bits, err)
+					return
+				}
+				if res != uint64((plaintext1+plaintext2)*scaler) {
+					errs <- fmt.Errorf("%d bits: decrypted %d, expect %d", bits, res, (plaintext1+plaintext2)*scaler)
+				}
+			}(bits, key)
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
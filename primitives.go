@@ -0,0 +1,78 @@
+package pailliersdk
+
+import (
+	"math/big"
+)
+
+// paillier encryption method, keys and ciphertexts are validated here and
+// the arithmetic is done by the backend the key is bound to
+
+// GenerateKey generates a key pair whose modulus has the given bit length.
+func GenerateKey(bits int) (*PrivateKey, error) {
+	return generateKey(DefaultBackend(), bits)
+}
+
+func generateKey(b Backend, bits int) (*PrivateKey, error) {
+	if err := checkKeySize(bits); err != nil {
+		return nil, err
+	}
+	return b.KeyGen(bits)
+}
+
+// Encrypt encrypts msg under the public key.
+func (pk *PublicKey) Encrypt(msg uint64) (Ciphertext, error) {
+	return EncryptBig(pk, new(big.Int).SetUint64(msg))
+}
+
+// Decrypt decrypts the ciphertext, the plaintext is truncated to 64 bits,
+// use DecryptBig for the full plaintext space.
+func (sk *PrivateKey) Decrypt(cipher Ciphertext) (uint64, error) {
+	plain, err := DecryptBig(sk, cipher)
+	if err != nil {
+		return 0, err
+	}
+	return plain.Uint64(), nil
+}
+
+// EncryptBig encrypts any plaintext 0 <= msg < n under the public key.
+func EncryptBig(pk *PublicKey, msg *big.Int) (Ciphertext, error) {
+	if err := pk.validatePlaintext(msg); err != nil {
+		return Ciphertext{}, err
+	}
+	return pk.Backend().Encrypt(pk, msg)
+}
+
+// DecryptBig decrypts the ciphertext to a plaintext in [0, n).
+func DecryptBig(sk *PrivateKey, cipher Ciphertext) (*big.Int, error) {
+	if err := sk.ValidateCiphertext(cipher); err != nil {
+		return nil, err
+	}
+	return sk.Backend().Decrypt(sk, cipher)
+}
+
+// Add returns a ciphertext of the sum of the two plaintexts.
+func (pk *PublicKey) Add(cipher1, cipher2 Ciphertext) (Ciphertext, error) {
+	if err := pk.ValidateCiphertext(cipher1); err != nil {
+		return Ciphertext{}, err
+	}
+	if err := pk.ValidateCiphertext(cipher2); err != nil {
+		return Ciphertext{}, err
+	}
+	return pk.Backend().Add(pk, cipher1, cipher2)
+}
+
+// MulScalar returns a ciphertext of the plaintext multiplied by scalar.
+func (pk *PublicKey) MulScalar(cipher Ciphertext, scalar uint64) (Ciphertext, error) {
+	return MulScalarBig(pk, cipher, new(big.Int).SetUint64(scalar))
+}
+
+// MulScalarBig returns a ciphertext of the plaintext multiplied by any scalar 0 <= k < n.
+func MulScalarBig(pk *PublicKey, cipher Ciphertext, scalar *big.Int) (Ciphertext, error) {
+	if err := pk.ValidateCiphertext(cipher); err != nil {
+		return Ciphertext{}, err
+	}
+	if err := pk.validatePlaintext(scalar); err != nil {
+		return Ciphertext{}, err
+	}
+	return pk.Backend().MulScalar(pk, cipher, scalar)
+}
@@ -0,0 +1,220 @@
+package pailliersdk
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Non-interactive zero-knowledge proofs about ciphertexts, sigma protocols
+// made non-interactive with the Fiat–Shamir transform over sha256.
+
+// EncryptionProof proves the knowledge of the plaintext and the randomness
+// of a ciphertext.
+type EncryptionProof struct {
+	E, Z1, Z2 *big.Int
+}
+
+// DecryptionProof proves that a ciphertext decrypts to a given plaintext.
+type DecryptionProof struct {
+	E, Z *big.Int
+}
+
+// challengeBits bounds the challenges below the smallest factor of n, which
+// keeps the proofs sound
+func (pk *PublicKey) challengeBits() uint {
+	if bits := pk.Bits/2 - 1; bits < 256 {
+		return uint(bits)
+	}
+	return 256
+}
+
+// challenge hashes the transcript to a challenge of challengeBits
+func (pk *PublicKey) challenge(values ...*big.Int) *big.Int {
+	e := proofChallenge(append([]*big.Int{pk.N}, values...)...)
+	return e.Rsh(e, 256-pk.challengeBits())
+}
+
+// DecryptWithProof decrypts c and proves that c*(1+n)^-m is an n-th residue
+// mod n^2, the verifier learns nothing about the randomness of c.
+func DecryptWithProof(sk *PrivateKey, c Ciphertext) (*big.Int, *DecryptionProof, error) {
+	m, err := DecryptBig(sk, c)
+	if err != nil {
+		return nil, nil, err
+	}
+	// u = r^n mod n^2, r = u^{n^-1 mod lambda} mod n
+	u := sk.residue(c, m)
+	r := new(big.Int).ModInverse(sk.N, sk.Lambda)
+	r.Exp(u, r, sk.N)
+
+	// a = rho^n, z = rho*r^e mod n
+	rho, err := randomUnit(sk.N)
+	if err != nil {
+		return nil, nil, err
+	}
+	a := new(big.Int).Exp(rho, sk.N, sk.NSquared)
+	proof := &DecryptionProof{E: sk.challenge(c.C, m, a)}
+	proof.Z = r.Exp(r, proof.E, sk.N)
+	proof.Z.Mul(proof.Z, rho).Mod(proof.Z, sk.N)
+	return m, proof, nil
+}
+
+// residue returns c*(1+n)^-m mod n^2
+func (pk *PublicKey) residue(c Ciphertext, m *big.Int) *big.Int {
+	// (1+n)^-m = 1 - m*n mod n^2
+	u := new(big.Int).Mul(m, pk.N)
+	u.Sub(one, u)
+	u.Mul(u, c.C)
+	return u.Mod(u, pk.NSquared)
+}
+
+// VerifyDecryption checks a proof that c decrypts to m, a signed plaintext is
+// checked through its encoding mod n.
+func VerifyDecryption(pk *PublicKey, c Ciphertext, m *big.Int, proof *DecryptionProof) error {
+	if err := pk.ValidateCiphertext(c); err != nil {
+		return err
+	}
+	if proof == nil || proof.E == nil || proof.Z == nil || proof.Z.Sign() <= 0 || proof.Z.Cmp(pk.N) >= 0 ||
+		proof.E.Sign() < 0 || proof.E.BitLen() > int(pk.challengeBits()) {
+		return fmt.Errorf("%w: malformed decryption proof", ErrInvalidProof)
+	}
+	m = new(big.Int).Mod(m, pk.N)
+	// a = z^n * u^-e mod n^2
+	u := pk.residue(c, m)
+	if u.Exp(u, proof.E, pk.NSquared).ModInverse(u, pk.NSquared) == nil {
+		return fmt.Errorf("%w: decryption proof", ErrInvalidProof)
+	}
+	a := new(big.Int).Exp(proof.Z, pk.N, pk.NSquared)
+	a.Mul(a, u).Mod(a, pk.NSquared)
+	if pk.challenge(c.C, m, a).Cmp(proof.E) != 0 {
+		return fmt.Errorf("%w: ciphertext does not decrypt to %s", ErrInvalidProof, m)
+	}
+	return nil
+}
+
+// EncryptWithProof encrypts 0 <= m < n and proves the knowledge of m and r
+// with c = (1+n)^m * r^n mod n^2. The proof is bound to c, a re-randomized
+// copy of c needs a proof of its own.
+func EncryptWithProof(pk *PublicKey, m *big.Int) (Ciphertext, *EncryptionProof, error) {
+	if err := pk.validatePlaintext(m); err != nil {
+		return Ciphertext{}, nil, err
+	}
+	r, err := randomUnit(pk.N)
+	if err != nil {
+		return Ciphertext{}, nil, err
+	}
+	cm, err := pk.encryptWith(m, r)
+	if err != nil {
+		return Ciphertext{}, nil, err
+	}
+	c := pk.NewCiphertext(cm)
+
+	// a = (1+n)^x * s^n, z1 = x + e*m mod n, z2 = s * r^e mod n
+	x, err := rand.Int(rand.Reader, pk.N)
+	if err != nil {
+		return Ciphertext{}, nil, err
+	}
+	s, err := randomUnit(pk.N)
+	if err != nil {
+		return Ciphertext{}, nil, err
+	}
+	a, err := pk.encryptWith(x, s)
+	if err != nil {
+		return Ciphertext{}, nil, err
+	}
+	proof := &EncryptionProof{E: pk.challenge(c.C, a)}
+	proof.Z1 = new(big.Int).Mul(proof.E, m)
+	proof.Z1.Add(proof.Z1, x).Mod(proof.Z1, pk.N)
+	// as (1+n)^n = 1 mod n^2 the carry of z1 drops out, s*r^e is reduced mod n
+	// since the n-th power only depends on its class mod n
+	proof.Z2 = r.Exp(r, proof.E, pk.N)
+	proof.Z2.Mul(proof.Z2, s).Mod(proof.Z2, pk.N)
+	return c, proof, nil
+}
+
+// EncryptBatchWithProofs encrypts plaintexts 0 <= m < n as EncryptWithProof
+// does, errors are reported as in EncryptBatch. The randomness is always
+// fresh, an encryptor set with WithBatchEncryptor is not used.
+func EncryptBatchWithProofs(ctx context.Context, pk *PublicKey, msgs []*big.Int, opts ...BatchOption) ([]Ciphertext, []*EncryptionProof, error) {
+	ciphers := make([]Ciphertext, len(msgs))
+	proofs := make([]*EncryptionProof, len(msgs))
+	err := runBatch(ctx, len(msgs), newBatchConfig(opts).workers, func(i int) error {
+		var err error
+		ciphers[i], proofs[i], err = EncryptWithProof(pk, msgs[i])
+		return err
+	})
+	return ciphers, proofs, err
+}
+
+// encryptWith returns (1+n)^m * r^n mod n^2, r^n is computed by the backend
+func (pk *PublicKey) encryptWith(m, r *big.Int) (*big.Int, error) {
+	rn, err := pk.Backend().MulScalar(pk, pk.NewCiphertext(r), pk.N)
+	if err != nil {
+		return nil, err
+	}
+	// (1+n)^m = 1 + m*n mod n^2
+	c := new(big.Int).Mul(m, pk.N)
+	c.Add(c, one)
+	c.Mul(c, rn.C)
+	return c.Mod(c, pk.NSquared), nil
+}
+
+// VerifyEncryption checks a proof that the creator of c knows its plaintext.
+func VerifyEncryption(pk *PublicKey, c Ciphertext, proof *EncryptionProof) error {
+	if err := pk.ValidateCiphertext(c); err != nil {
+		return err
+	}
+	if proof == nil || proof.E == nil || proof.Z1 == nil || proof.Z2 == nil ||
+		proof.E.Sign() < 0 || proof.E.BitLen() > int(pk.challengeBits()) ||
+		proof.Z1.Sign() < 0 || proof.Z1.Cmp(pk.N) >= 0 || proof.Z2.Sign() <= 0 || proof.Z2.Cmp(pk.N) >= 0 {
+		return fmt.Errorf("%w: malformed encryption proof", ErrInvalidProof)
+	}
+	// a = (1+n)^z1 * z2^n * c^-e mod n^2
+	b, err := pk.encryptWith(proof.Z1, proof.Z2)
+	if err != nil {
+		return err
+	}
+	a := new(big.Int).Exp(c.C, proof.E, pk.NSquared)
+	a.ModInverse(a, pk.NSquared)
+	a.Mul(a, b).Mod(a, pk.NSquared)
+	if pk.challenge(c.C, a).Cmp(proof.E) != 0 {
+		return fmt.Errorf("%w: encryption proof does not verify", ErrInvalidProof)
+	}
+	return nil
+}
+
+// String returns the challenge and the responses in hex separated by ':'.
+func (p *EncryptionProof) String() string {
+	return strings.Join([]string{p.E.Text(16), p.Z1.Text(16), p.Z2.Text(16)}, ":")
+}
+
+// ParseEncryptionProof parses the String of an encryption proof.
+func ParseEncryptionProof(s string) (*EncryptionProof, error) {
+	ints, err := parseHexInts(strings.Split(s, ":"), ErrInvalidProof)
+	if err != nil {
+		return nil, err
+	}
+	if len(ints) != 3 {
+		return nil, fmt.Errorf("%w: expect e:z1:z2", ErrInvalidProof)
+	}
+	return &EncryptionProof{E: ints[0], Z1: ints[1], Z2: ints[2]}, nil
+}
+
+// String returns the challenge and the response in hex separated by ':'.
+func (p *DecryptionProof) String() string {
+	return p.E.Text(16) + ":" + p.Z.Text(16)
+}
+
+// ParseDecryptionProof parses the String of a decryption proof.
+func ParseDecryptionProof(s string) (*DecryptionProof, error) {
+	ints, err := parseHexInts(strings.Split(s, ":"), ErrInvalidProof)
+	if err != nil {
+		return nil, err
+	}
+	if len(ints) != 2 {
+		return nil, fmt.Errorf("%w: expect e:z", ErrInvalidProof)
+	}
+	return &DecryptionProof{E: ints[0], Z: ints[1]}, nil
+}
@@ -0,0 +1,110 @@
+package pailliersdk
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestDecryptionProof(t *testing.T) {
+	sk, err := GenerateKey(512)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+	c, _ := EncryptSigned(pk, big.NewInt(-77))
+	m, proof, err := DecryptWithProof(sk, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyDecryption(pk, c, m, proof); err != nil {
+		t.Fatal(err)
+	}
+	// the signed plaintext verifies through its encoding
+	if err := VerifyDecryption(pk, c, big.NewInt(-77), proof); err != nil {
+		t.Fatal(err)
+	}
+	if proof, err = ParseDecryptionProof(proof.String()); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyDecryption(pk, c, m, proof); err != nil {
+		t.Fatal(err)
+	}
+
+	// another plaintext, another ciphertext or a tampered proof fail
+	if err := VerifyDecryption(pk, c, big.NewInt(-76), proof); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+	c2, _ := EncryptSigned(pk, big.NewInt(-77))
+	if err := VerifyDecryption(pk, c2, m, proof); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+	tampered := &DecryptionProof{E: proof.E, Z: new(big.Int).Add(proof.Z, one)}
+	if err := VerifyDecryption(pk, c, m, tampered); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+	if _, err := ParseDecryptionProof("12"); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+}
+
+func TestEncryptionProof(t *testing.T) {
+	sk, err := GenerateKey(512)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+	m := big.NewInt(2021)
+	c, proof, err := EncryptWithProof(pk, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, _ := DecryptBig(sk, c); res.Cmp(m) != 0 {
+		t.Fatalf("decrypted %s, expect %s", res, m)
+	}
+	if err := VerifyEncryption(pk, c, proof); err != nil {
+		t.Fatal(err)
+	}
+	if proof, err = ParseEncryptionProof(proof.String()); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyEncryption(pk, c, proof); err != nil {
+		t.Fatal(err)
+	}
+
+	// the proof does not carry over to a re-randomized copy
+	copied, err := Rerandomize(pk, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyEncryption(pk, copied, proof); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+	tampered := &EncryptionProof{E: proof.E, Z1: new(big.Int).Add(proof.Z1, one), Z2: proof.Z2}
+	if err := VerifyEncryption(pk, c, tampered); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+	if _, _, err := EncryptWithProof(pk, pk.N); err == nil {
+		t.Fatal("plaintext n should be rejected")
+	}
+
+	// backend failures are passed up
+	broken := pk.WithBackend(failingBackend{pk.Backend()})
+	if _, _, err := EncryptWithProof(broken, m); !errors.Is(err, errBackendFailure) {
+		t.Fatalf("expect the backend error, got %v", err)
+	}
+	if err := VerifyEncryption(broken, c, proof); !errors.Is(err, errBackendFailure) {
+		t.Fatalf("expect the backend error, got %v", err)
+	}
+}
+
+var errBackendFailure = errors.New("backend failure")
+
+// failingBackend fails every scalar multiplication
+type failingBackend struct {
+	Backend
+}
+
+func (failingBackend) MulScalar(pk *PublicKey, cipher Ciphertext, scalar *big.Int) (Ciphertext, error) {
+	return Ciphertext{}, errBackendFailure
+}
@@ -0,0 +1,327 @@
+package pailliersdk
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Range proofs by digit decomposition: the prover encrypts the digits
+// d_1..d_{D-1} of m in the given radix and proves with an OR of n-th
+// residuosity proofs that every digit ciphertext holds a value in
+// [0, radix). Digit 0 is not sent, the verifier derives its ciphertext as
+// c / prod c_i^{radix^i}, so a proof for bits = 1 is a single 0/1 proof on c.
+// Radix 4 is the compact variant, it sends half the digit ciphertexts of
+// radix 2 at the price of four branches per OR proof.
+
+// RangeProof proves that a ciphertext holds a plaintext in [0, 2^Bits).
+type RangeProof struct {
+	Bits   int
+	Radix  int           // 2 or 4
+	Digits []Ciphertext  // the encrypted digits 1..D-1
+	Proofs []*digitProof // one per digit, digit 0 first
+}
+
+// digitProof is an OR proof with one challenge and response per value
+type digitProof struct {
+	E, Z []*big.Int
+}
+
+// digitWidth returns the number of bits of a digit
+func digitWidth(radix int) (uint, error) {
+	switch radix {
+	case 2:
+		return 1, nil
+	case 4:
+		return 2, nil
+	}
+	return 0, fmt.Errorf("%w: radix must be 2 or 4, got %d", ErrInvalidProof, radix)
+}
+
+// rangeLayout returns the widths of the digits of a bits wide range
+func (pk *PublicKey) rangeLayout(bits, radix int) ([]uint, error) {
+	w, err := digitWidth(radix)
+	if err != nil {
+		return nil, err
+	}
+	if bits < 1 || bits >= pk.Bits-1 {
+		return nil, fmt.Errorf("%w: range of %d bits, expect 1 to %d", ErrInvalidProof, bits, pk.Bits-2)
+	}
+	var widths []uint
+	for left := uint(bits); left > 0; left -= widths[len(widths)-1] {
+		if left < w {
+			widths = append(widths, left)
+		} else {
+			widths = append(widths, w)
+		}
+	}
+	return widths, nil
+}
+
+// EncryptWithRangeProof encrypts 0 <= m < 2^bits and proves the range with
+// digits of the given radix, 2 or 4.
+func EncryptWithRangeProof(pk *PublicKey, m *big.Int, bits, radix int) (Ciphertext, *RangeProof, error) {
+	if err := pk.validatePlaintext(m); err != nil {
+		return Ciphertext{}, nil, err
+	}
+	r, err := randomUnit(pk.N)
+	if err != nil {
+		return Ciphertext{}, nil, err
+	}
+	proof, err := ProveRange(pk, m, r, bits, radix)
+	if err != nil {
+		return Ciphertext{}, nil, err
+	}
+	c, err := pk.encryptWith(m, r)
+	if err != nil {
+		return Ciphertext{}, nil, err
+	}
+	return pk.NewCiphertext(c), proof, nil
+}
+
+// ProveRange proves that the ciphertext (1+n)^m * r^n mod n^2 holds a
+// plaintext in [0, 2^bits), with digits of the given radix, 2 or 4.
+func ProveRange(pk *PublicKey, m, r *big.Int, bits, radix int) (*RangeProof, error) {
+	widths, err := pk.rangeLayout(bits, radix)
+	if err != nil {
+		return nil, err
+	}
+	if m.Sign() < 0 || m.BitLen() > bits {
+		return nil, fmt.Errorf("%w: plaintext out of the range of %d bits", ErrInvalidPlaintext, bits)
+	}
+	cm, err := pk.encryptWith(m, r)
+	if err != nil {
+		return nil, err
+	}
+	c := pk.NewCiphertext(cm)
+	proof := &RangeProof{Bits: bits, Radix: radix}
+
+	// digits 1..D-1 get fresh randomness, r_0 = r / prod r_i^{2^offset_i}
+	r0 := new(big.Int).Set(r)
+	values := make([]int64, len(widths))
+	rands := make([]*big.Int, len(widths))
+	offset := widths[0]
+	for i := 1; i < len(widths); i++ {
+		values[i] = int64(new(big.Int).Rsh(m, offset).Uint64() & (1<<widths[i] - 1))
+		if rands[i], err = randomUnit(pk.N); err != nil {
+			return nil, err
+		}
+		d, err := pk.encryptWith(big.NewInt(values[i]), rands[i])
+		if err != nil {
+			return nil, err
+		}
+		proof.Digits = append(proof.Digits, pk.NewCiphertext(d))
+		t := new(big.Int).Exp(rands[i], new(big.Int).Lsh(one, offset), pk.N)
+		r0.Mul(r0, t.ModInverse(t, pk.N)).Mod(r0, pk.N)
+		offset += widths[i]
+	}
+	values[0] = int64(new(big.Int).And(m, big.NewInt(1<<widths[0]-1)).Uint64())
+	rands[0] = r0
+
+	digits := pk.rangeDigits(c, proof.Digits, widths)
+	for i, d := range digits {
+		p, err := pk.proveDigit(d, digitStatement(c, d, bits, radix, i), values[i], rands[i], 1<<widths[i])
+		if err != nil {
+			return nil, err
+		}
+		proof.Proofs = append(proof.Proofs, p)
+	}
+	return proof, nil
+}
+
+// rangeDigits returns the ciphertexts of all digits, digit 0 is derived from c
+func (pk *PublicKey) rangeDigits(c Ciphertext, sent []Ciphertext, widths []uint) []Ciphertext {
+	c0 := new(big.Int).Set(c.C)
+	offset := widths[0]
+	for i, d := range sent {
+		t := new(big.Int).Exp(d.C, new(big.Int).Lsh(one, offset), pk.NSquared)
+		c0.Mul(c0, t.ModInverse(t, pk.NSquared)).Mod(c0, pk.NSquared)
+		offset += widths[i+1]
+	}
+	return append([]Ciphertext{pk.NewCiphertext(c0)}, sent...)
+}
+
+// digitStatement binds the challenge of a digit proof to the ciphertext, the
+// range, the radix and the position of the digit, so that a digit proof can
+// not be replayed under another statement
+func digitStatement(c, d Ciphertext, bits, radix, index int) []*big.Int {
+	return []*big.Int{c.C, big.NewInt(int64(bits)), big.NewInt(int64(radix)), big.NewInt(int64(index)), d.C}
+}
+
+// proveDigit proves that d encrypts one of 0..size-1: one of d*(1+n)^-j is an
+// n-th residue. The branches of the other values are simulated and the
+// challenges sum up to the hash of the statement and the commitments.
+func (pk *PublicKey) proveDigit(d Ciphertext, statement []*big.Int, value int64, rho *big.Int, size int) (*digitProof, error) {
+	mask := new(big.Int).Sub(new(big.Int).Lsh(one, pk.challengeBits()), one)
+	p := &digitProof{E: make([]*big.Int, size), Z: make([]*big.Int, size)}
+	a := make([]*big.Int, size)
+	sum := new(big.Int)
+	var s *big.Int
+	var err error
+	for j := 0; j < size; j++ {
+		if int64(j) == value {
+			if s, err = randomUnit(pk.N); err != nil {
+				return nil, err
+			}
+			if a[j], err = pk.encryptWith(new(big.Int), s); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		// a_j = z_j^n * u_j^-e_j
+		if p.E[j], err = rand.Int(rand.Reader, new(big.Int).Add(mask, one)); err != nil {
+			return nil, err
+		}
+		if p.Z[j], err = randomUnit(pk.N); err != nil {
+			return nil, err
+		}
+		u := new(big.Int).Exp(pk.residue(d, big.NewInt(int64(j))), p.E[j], pk.NSquared)
+		if a[j], err = pk.encryptWith(new(big.Int), p.Z[j]); err != nil {
+			return nil, err
+		}
+		a[j].Mul(a[j], u.ModInverse(u, pk.NSquared)).Mod(a[j], pk.NSquared)
+		sum.Add(sum, p.E[j])
+	}
+	e := pk.challenge(append(statement, a...)...)
+	p.E[value] = e.Sub(e, sum).And(e, mask)
+	p.Z[value] = new(big.Int).Exp(rho, p.E[value], pk.N)
+	p.Z[value].Mul(p.Z[value], s).Mod(p.Z[value], pk.N)
+	return p, nil
+}
+
+// verifyDigit checks the OR proof that d encrypts one of 0..size-1, it only
+// fails if the backend does
+func (pk *PublicKey) verifyDigit(d Ciphertext, statement []*big.Int, p *digitProof, size int) (bool, error) {
+	if len(p.E) != size || len(p.Z) != size {
+		return false, nil
+	}
+	mask := new(big.Int).Sub(new(big.Int).Lsh(one, pk.challengeBits()), one)
+	a := make([]*big.Int, size)
+	sum := new(big.Int)
+	for j := 0; j < size; j++ {
+		if p.E[j] == nil || p.Z[j] == nil || p.E[j].Sign() < 0 || p.E[j].Cmp(mask) > 0 || p.Z[j].Sign() <= 0 || p.Z[j].Cmp(pk.N) >= 0 {
+			return false, nil
+		}
+		u := new(big.Int).Exp(pk.residue(d, big.NewInt(int64(j))), p.E[j], pk.NSquared)
+		if u.ModInverse(u, pk.NSquared) == nil {
+			return false, nil
+		}
+		var err error
+		if a[j], err = pk.encryptWith(new(big.Int), p.Z[j]); err != nil {
+			return false, err
+		}
+		a[j].Mul(a[j], u).Mod(a[j], pk.NSquared)
+		sum.Add(sum, p.E[j])
+	}
+	e := pk.challenge(append(statement, a...)...)
+	return sum.And(sum, mask).Cmp(e) == 0, nil
+}
+
+// VerifyRange checks that c holds a plaintext in [0, 2^bits).
+func VerifyRange(pk *PublicKey, c Ciphertext, bits int, proof *RangeProof) error {
+	valid, err := CheckRange(pk, c, bits, proof)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return fmt.Errorf("%w: plaintext not proven in [0, 2^%d)", ErrInvalidProof, bits)
+	}
+	return nil
+}
+
+// CheckRange reports whether the proof shows that c holds a plaintext in
+// [0, 2^bits). Unlike VerifyRange it only fails for malformed input, a well
+// formed proof of another range or of a plaintext out of range is false.
+func CheckRange(pk *PublicKey, c Ciphertext, bits int, proof *RangeProof) (bool, error) {
+	if err := pk.ValidateCiphertext(c); err != nil {
+		return false, err
+	}
+	if proof == nil {
+		return false, fmt.Errorf("%w: no range proof", ErrInvalidProof)
+	}
+	widths, err := pk.rangeLayout(proof.Bits, proof.Radix)
+	if err != nil {
+		return false, err
+	}
+	if len(proof.Digits) != len(widths)-1 || len(proof.Proofs) != len(widths) {
+		return false, fmt.Errorf("%w: expect %d digits", ErrInvalidProof, len(widths))
+	}
+	for i, d := range proof.Digits {
+		if err := pk.ValidateCiphertext(d); err != nil {
+			return false, fmt.Errorf("%w: digit %d, %v", ErrInvalidProof, i+1, err)
+		}
+	}
+	for i, p := range proof.Proofs {
+		if p == nil || len(p.E) != 1<<widths[i] || len(p.Z) != 1<<widths[i] {
+			return false, fmt.Errorf("%w: digit %d, expect %d branches", ErrInvalidProof, i, 1<<widths[i])
+		}
+	}
+	if proof.Bits != bits {
+		return false, nil
+	}
+	for i, d := range pk.rangeDigits(c, proof.Digits, widths) {
+		valid, err := pk.verifyDigit(d, digitStatement(c, d, proof.Bits, proof.Radix, i), proof.Proofs[i], 1<<widths[i])
+		if err != nil || !valid {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// String returns bits, radix, the digit ciphertexts and the challenges and
+// responses of the digit proofs in hex separated by ':'.
+func (p *RangeProof) String() string {
+	parts := []string{strconv.FormatInt(int64(p.Bits), 16), strconv.FormatInt(int64(p.Radix), 16)}
+	for _, d := range p.Digits {
+		parts = append(parts, d.C.Text(16))
+	}
+	for _, dp := range p.Proofs {
+		for j := range dp.E {
+			parts = append(parts, dp.E[j].Text(16), dp.Z[j].Text(16))
+		}
+	}
+	return strings.Join(parts, ":")
+}
+
+// ParseRangeProof parses the String of a range proof under the given public key.
+func ParseRangeProof(pk *PublicKey, s string) (*RangeProof, error) {
+	if pk == nil {
+		return nil, ErrInvalidPublicKey
+	}
+	ints, err := parseHexInts(strings.Split(s, ":"), ErrInvalidProof)
+	if err != nil {
+		return nil, err
+	}
+	if len(ints) < 2 || !ints[0].IsInt64() || !ints[1].IsInt64() || ints[0].Int64() >= int64(pk.Bits) {
+		return nil, fmt.Errorf("%w: expect bits:radix:digits:proofs", ErrInvalidProof)
+	}
+	p := &RangeProof{Bits: int(ints[0].Int64()), Radix: int(ints[1].Int64())}
+	widths, err := pk.rangeLayout(p.Bits, p.Radix)
+	if err != nil {
+		return nil, err
+	}
+	size := 2 + len(widths) - 1
+	for _, w := range widths {
+		size += 2 << w
+	}
+	if len(ints) != size {
+		return nil, fmt.Errorf("%w: expect %d values, got %d", ErrInvalidProof, size, len(ints))
+	}
+	ints = ints[2:]
+	for i := 1; i < len(widths); i++ {
+		p.Digits = append(p.Digits, pk.NewCiphertext(ints[0]))
+		ints = ints[1:]
+	}
+	for _, w := range widths {
+		dp := &digitProof{}
+		for j := 0; j < 1<<w; j++ {
+			dp.E = append(dp.E, ints[0])
+			dp.Z = append(dp.Z, ints[1])
+			ints = ints[2:]
+		}
+		p.Proofs = append(p.Proofs, dp)
+	}
+	return p, nil
+}
@@ -0,0 +1,118 @@
+package pailliersdk
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestRangeProof(t *testing.T) {
+	sk, err := GenerateKey(512)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+	for _, radix := range []int{2, 4} {
+		for _, tc := range []struct {
+			m    int64
+			bits int
+		}{{0, 1}, {1, 1}, {0, 8}, {255, 8}, {1000, 11}, {12345, 16}} {
+			c, proof, err := EncryptWithRangeProof(pk, big.NewInt(tc.m), tc.bits, radix)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res, _ := DecryptBig(sk, c); res.Int64() != tc.m {
+				t.Fatalf("decrypted %s, expect %d", res, tc.m)
+			}
+			if err := VerifyRange(pk, c, tc.bits, proof); err != nil {
+				t.Fatalf("radix %d, %d in %d bits: %v", radix, tc.m, tc.bits, err)
+			}
+			if proof, err = ParseRangeProof(pk, proof.String()); err != nil {
+				t.Fatal(err)
+			}
+			if err := VerifyRange(pk, c, tc.bits, proof); err != nil {
+				t.Fatal(err)
+			}
+			// the proof holds for c only and for its own number of bits
+			if err := VerifyRange(pk, c, tc.bits+1, proof); !errors.Is(err, ErrInvalidProof) {
+				t.Fatalf("expect ErrInvalidProof, got %v", err)
+			}
+			other, _ := EncryptBig(pk, big.NewInt(tc.m))
+			if err := VerifyRange(pk, other, tc.bits, proof); !errors.Is(err, ErrInvalidProof) {
+				t.Fatalf("expect ErrInvalidProof, got %v", err)
+			}
+		}
+	}
+
+	// the compact proof sends half the digits
+	_, binary, _ := EncryptWithRangeProof(pk, big.NewInt(7), 32, 2)
+	_, compact, _ := EncryptWithRangeProof(pk, big.NewInt(7), 32, 4)
+	if len(binary.Digits) != 31 || len(compact.Digits) != 15 || len(compact.String()) >= len(binary.String()) {
+		t.Fatalf("binary proof of %d digits, compact of %d", len(binary.Digits), len(compact.Digits))
+	}
+
+	if _, _, err := EncryptWithRangeProof(pk, big.NewInt(256), 8, 2); !errors.Is(err, ErrInvalidPlaintext) {
+		t.Fatalf("expect ErrInvalidPlaintext, got %v", err)
+	}
+	if _, _, err := EncryptWithRangeProof(pk, big.NewInt(1), 8, 3); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+}
+
+// an out of range plaintext can not be proven by forging a digit
+func TestRangeProofForgery(t *testing.T) {
+	sk, err := GenerateKey(512)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+	// a proof for 2 run on a ciphertext of 2 + 2^8 with a shifted digit
+	r, _ := randomUnit(pk.N)
+	proof, err := ProveRange(pk, big.NewInt(2), r, 8, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cm, err := pk.encryptWith(big.NewInt(2+256), r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := pk.NewCiphertext(cm)
+	if err := VerifyRange(pk, c, 8, proof); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+	// a vote of 2 has no valid 0/1 proof
+	if _, err := ProveRange(pk, big.NewInt(2), r, 1, 2); !errors.Is(err, ErrInvalidPlaintext) {
+		t.Fatalf("expect ErrInvalidPlaintext, got %v", err)
+	}
+	proof.Digits[0], _ = EncryptBig(pk, big.NewInt(2))
+	c2, err := pk.encryptWith(big.NewInt(2), r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyRange(pk, pk.NewCiphertext(c2), 8, proof); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+
+	// the digit proofs are bound to the radix, a 0/1 proof in radix 2 has the
+	// layout of radix 4 but does not carry over
+	vote, bit, err := EncryptWithRangeProof(pk, big.NewInt(1), 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid, err := CheckRange(pk, vote, 1, bit); err != nil || !valid {
+		t.Fatalf("expect a valid proof, got %v, %v", valid, err)
+	}
+	bit.Radix = 4
+	if valid, err := CheckRange(pk, vote, 1, bit); err != nil || valid {
+		t.Fatalf("expect an invalid proof, got %v, %v", valid, err)
+	}
+	// a proof of another range is invalid, a malformed one an error
+	bit.Radix = 2
+	if valid, err := CheckRange(pk, vote, 2, bit); err != nil || valid {
+		t.Fatalf("expect an invalid proof, got %v, %v", valid, err)
+	}
+	bit.Proofs = nil
+	if _, err := CheckRange(pk, vote, 1, bit); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package pailliersdk
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Signed integers are encoded into Z_n by mapping v < 0 to n+v, so plaintexts
+// in [0, n/2] are non-negative and plaintexts in (n/2, n) are negative. Sums
+// and scalar products stay correct as long as the result fits in (-n/2, n/2].
+
+// EncodeSigned maps a signed integer with |v| <= (n-1)/2 to a plaintext in Z_n.
+func (pk *PublicKey) EncodeSigned(v *big.Int) (*big.Int, error) {
+	half := new(big.Int).Rsh(pk.N, 1)
+	if new(big.Int).Abs(v).Cmp(half) > 0 {
+		return nil, fmt.Errorf("%w: |v| exceeds n/2", ErrPlaintextTooLarge)
+	}
+	if v.Sign() < 0 {
+		return new(big.Int).Add(pk.N, v), nil
+	}
+	return new(big.Int).Set(v), nil
+}
+
+// DecodeSigned maps a plaintext in Z_n back to a signed integer.
+func (pk *PublicKey) DecodeSigned(m *big.Int) *big.Int {
+	half := new(big.Int).Rsh(pk.N, 1)
+	if m.Cmp(half) > 0 {
+		return new(big.Int).Sub(m, pk.N)
+	}
+	return new(big.Int).Set(m)
+}
+
+// EncryptSigned encrypts a signed integer under the public key.
+func EncryptSigned(pk *PublicKey, v *big.Int) (Ciphertext, error) {
+	m, err := pk.EncodeSigned(v)
+	if err != nil {
+		return Ciphertext{}, err
+	}
+	return EncryptBig(pk, m)
+}
+
+// DecryptSigned decrypts a ciphertext of a signed integer.
+func DecryptSigned(sk *PrivateKey, cipher Ciphertext) (*big.Int, error) {
+	m, err := DecryptBig(sk, cipher)
+	if err != nil {
+		return nil, err
+	}
+	return sk.DecodeSigned(m), nil
+}
+
+// EncryptInt64 encrypts a signed 64-bit integer under the public key.
+func EncryptInt64(pk *PublicKey, v int64) (Ciphertext, error) {
+	return EncryptSigned(pk, big.NewInt(v))
+}
+
+// DecryptInt64 decrypts a ciphertext of a signed integer, it fails with
+// ErrPlaintextTooLarge when the result does not fit in an int64.
+func DecryptInt64(sk *PrivateKey, cipher Ciphertext) (int64, error) {
+	v, err := DecryptSigned(sk, cipher)
+	if err != nil {
+		return 0, err
+	}
+	if !v.IsInt64() {
+		return 0, fmt.Errorf("%w: %s overflows int64", ErrPlaintextTooLarge, v)
+	}
+	return v.Int64(), nil
+}
+
+// MulScalarSigned returns a ciphertext of the plaintext multiplied by a signed scalar.
+func MulScalarSigned(pk *PublicKey, cipher Ciphertext, scalar *big.Int) (Ciphertext, error) {
+	k, err := pk.EncodeSigned(scalar)
+	if err != nil {
+		return Ciphertext{}, err
+	}
+	return MulScalarBig(pk, cipher, k)
+}
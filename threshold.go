@@ -0,0 +1,439 @@
+package pailliersdk
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Threshold decryption in the style of Shoup and Damgård–Jurik. The dealer
+// picks d = 0 mod lambda, d = 1 mod n and shares it with a random polynomial
+// of degree t-1 over Z_{n*lambda}, node i holds s_i = f(i). A partial
+// decryption is c_i = c^{2*Delta*s_i} with Delta = l!, any t of them combine
+// to c^{4*Delta^2*d} = (1+n)^{4*Delta^2*m} mod n^2. Every partial decryption
+// carries a proof that log_{c^4}(c_i^2) = log_v(v_i) for the public
+// verification key v_i = v^{Delta*s_i} of node i.
+
+// ThresholdPublicKey is the public part of a t-of-l sharing of a private key.
+type ThresholdPublicKey struct {
+	PublicKey
+	T                int        // number of shares needed to decrypt
+	L                int        // number of shares
+	V                *big.Int   // random square of Z*_{n^2}
+	VerificationKeys []*big.Int // v^{Delta*s_i} for node i+1
+	// Unverifiable marks the keys of GenerateDistributedKey, the modulus is
+	// not of safe primes and the proofs of the partial decryptions mean
+	// nothing, see CombineUnverified
+	Unverifiable bool
+}
+
+// KeyShare is the secret share of node Index, 1 <= Index <= L.
+type KeyShare struct {
+	Index int
+	S     *big.Int
+}
+
+// PartialDecryption is the contribution of node Index to a decryption.
+type PartialDecryption struct {
+	Index int
+	C     *big.Int // c^{2*Delta*s_i} mod n^2
+	E, Z  *big.Int // proof of equal discrete logs
+}
+
+// GenerateThresholdKey generates a key of safe primes and deals it into l
+// shares of which any t decrypt. Safe primes take much longer to find than
+// the primes of GenerateKey.
+func GenerateThresholdKey(bits, t, l int) (*ThresholdPublicKey, []*KeyShare, error) {
+	if err := checkKeySize(bits); err != nil {
+		return nil, nil, err
+	}
+	if err := checkThreshold(t, l); err != nil {
+		return nil, nil, err
+	}
+	for {
+		p, err := safePrime(bits / 2)
+		if err != nil {
+			return nil, nil, err
+		}
+		q, err := safePrime(bits / 2)
+		if err != nil {
+			return nil, nil, err
+		}
+		n := new(big.Int).Mul(p, q)
+		if p.Cmp(q) == 0 || n.BitLen() != bits {
+			continue
+		}
+		sk, err := NewPrivateKeyFromPrimes(NewPublicKey(n), p, q)
+		if err != nil {
+			return nil, nil, err
+		}
+		return DealShares(sk, t, l)
+	}
+}
+
+// safePrime returns a prime p = 2p'+1 of the given bits with p' prime
+func safePrime(bits int) (*big.Int, error) {
+	three := big.NewInt(3)
+	for {
+		p, err := rand.Prime(rand.Reader, bits-1)
+		if err != nil {
+			return nil, err
+		}
+		// 2p'+1 is divisible by 3 for p' = 1 mod 3
+		if new(big.Int).Mod(p, three).Cmp(one) == 0 {
+			continue
+		}
+		p.Lsh(p, 1).Add(p, one)
+		if p.ProbablyPrime(20) {
+			return p, nil
+		}
+	}
+}
+
+// isSafePrime reports whether p and (p-1)/2 are prime
+func isSafePrime(p *big.Int) bool {
+	return p.ProbablyPrime(20) && new(big.Int).Rsh(p, 1).ProbablyPrime(20)
+}
+
+// DealShares splits the private key into l shares of which any t decrypt.
+// The proofs of the partial decryptions are only sound for a modulus of safe
+// primes, so the key must carry its primes, as the keys of
+// GenerateThresholdKey do, and keys of other primes are rejected.
+func DealShares(sk *PrivateKey, t, l int) (*ThresholdPublicKey, []*KeyShare, error) {
+	if err := checkThreshold(t, l); err != nil {
+		return nil, nil, err
+	}
+	if sk.p == nil || sk.q == nil || !isSafePrime(sk.p) || !isSafePrime(sk.q) {
+		return nil, nil, fmt.Errorf("%w: threshold keys need a modulus of safe primes, see GenerateThresholdKey", ErrInvalidPrivateKey)
+	}
+	// d = lambda * (lambda^-1 mod n)
+	d := new(big.Int).ModInverse(sk.Lambda, sk.N)
+	d.Mul(d, sk.Lambda)
+	mod := new(big.Int).Mul(sk.N, sk.Lambda)
+	coeffs := []*big.Int{d}
+	for i := 1; i < t; i++ {
+		a, err := rand.Int(rand.Reader, mod)
+		if err != nil {
+			return nil, nil, err
+		}
+		coeffs = append(coeffs, a)
+	}
+
+	r, err := randomUnit(sk.NSquared)
+	if err != nil {
+		return nil, nil, err
+	}
+	tpk := &ThresholdPublicKey{
+		PublicKey: *NewPublicKey(sk.N),
+		T:         t,
+		L:         l,
+		V:         r.Mul(r, r).Mod(r, sk.NSquared),
+	}
+	delta := tpk.delta()
+	shares := make([]*KeyShare, l)
+	for i := range shares {
+		// f(i+1) by Horner's rule
+		x := big.NewInt(int64(i + 1))
+		s := new(big.Int)
+		for k := t - 1; k >= 0; k-- {
+			s.Mul(s, x)
+			s.Add(s, coeffs[k])
+			s.Mod(s, mod)
+		}
+		shares[i] = &KeyShare{Index: i + 1, S: s}
+		vk := new(big.Int).Mul(delta, s)
+		tpk.VerificationKeys = append(tpk.VerificationKeys, vk.Exp(tpk.V, vk, tpk.NSquared))
+	}
+	return tpk, shares, nil
+}
+
+// maxShares bounds l, Delta = l! grows quickly
+const maxShares = 255
+
+func checkThreshold(t, l int) error {
+	if t < 1 || l < t || l > maxShares {
+		return fmt.Errorf("%w: expect 1 <= t <= l <= %d, got t %d l %d", ErrInvalidShare, maxShares, t, l)
+	}
+	return nil
+}
+
+func (tpk *ThresholdPublicKey) delta() *big.Int {
+	return new(big.Int).MulRange(1, int64(tpk.L))
+}
+
+// PartialDecrypt computes the contribution of a share to decrypting cipher.
+func PartialDecrypt(tpk *ThresholdPublicKey, share *KeyShare, cipher Ciphertext) (*PartialDecryption, error) {
+	if share.Index < 1 || share.Index > tpk.L {
+		return nil, fmt.Errorf("%w: index %d out of range", ErrInvalidShare, share.Index)
+	}
+	if err := tpk.ValidateCiphertext(cipher); err != nil {
+		return nil, err
+	}
+	exp := new(big.Int).Mul(tpk.delta(), share.S)
+	exp.Lsh(exp, 1)
+	pd := &PartialDecryption{Index: share.Index, C: new(big.Int).Exp(cipher.C, exp, tpk.NSquared)}
+
+	// prove log_{c^4}(c_i^2) = log_v(v_i) = Delta*s_i, z = r + e*Delta*s_i over
+	// the integers with r large enough to hide e*Delta*s_i
+	secret := exp.Rsh(exp, 1)
+	c4, ci2, vi := tpk.proofBases(cipher, pd)
+	r, err := rand.Int(rand.Reader, new(big.Int).Lsh(one, uint(secret.BitLen()+2*sha256.Size*8)))
+	if err != nil {
+		return nil, err
+	}
+	a := new(big.Int).Exp(c4, r, tpk.NSquared)
+	b := new(big.Int).Exp(tpk.V, r, tpk.NSquared)
+	pd.E = proofChallenge(a, b, c4, ci2, tpk.V, vi)
+	pd.Z = new(big.Int).Mul(pd.E, secret)
+	pd.Z.Add(pd.Z, r)
+	return pd, nil
+}
+
+// proofBases returns c^4, c_i^2 and v_i
+func (tpk *ThresholdPublicKey) proofBases(cipher Ciphertext, pd *PartialDecryption) (c4, ci2, vi *big.Int) {
+	c4 = new(big.Int).Exp(cipher.C, big.NewInt(4), tpk.NSquared)
+	ci2 = new(big.Int).Mul(pd.C, pd.C)
+	ci2.Mod(ci2, tpk.NSquared)
+	return c4, ci2, tpk.VerificationKeys[pd.Index-1]
+}
+
+// proofChallenge hashes the transcript of a proof to its challenge
+func proofChallenge(values ...*big.Int) *big.Int {
+	h := sha256.New()
+	for _, v := range values {
+		b := v.Bytes()
+		h.Write([]byte{byte(len(b) >> 24), byte(len(b) >> 16), byte(len(b) >> 8), byte(len(b))})
+		h.Write(b)
+	}
+	return new(big.Int).SetBytes(h.Sum(nil))
+}
+
+// VerifyPartialDecryption checks the proof of a partial decryption of cipher.
+// It fails with ErrUnverifiablePartial for an Unverifiable key.
+func VerifyPartialDecryption(tpk *ThresholdPublicKey, cipher Ciphertext, pd *PartialDecryption) error {
+	if tpk.Unverifiable {
+		return fmt.Errorf("%w: the modulus is not of safe primes", ErrUnverifiablePartial)
+	}
+	if pd.Index < 1 || pd.Index > tpk.L {
+		return fmt.Errorf("%w: index %d out of range", ErrInvalidPartial, pd.Index)
+	}
+	if pd.C == nil || pd.E == nil || pd.Z == nil || pd.Z.Sign() < 0 ||
+		pd.C.Sign() <= 0 || pd.C.Cmp(tpk.NSquared) >= 0 {
+		return fmt.Errorf("%w: node %d, malformed", ErrInvalidPartial, pd.Index)
+	}
+	c4, ci2, vi := tpk.proofBases(cipher, pd)
+	// a = c4^z / ci2^e, b = v^z / vi^e
+	a := new(big.Int).Exp(ci2, pd.E, tpk.NSquared)
+	b := new(big.Int).Exp(vi, pd.E, tpk.NSquared)
+	if a.ModInverse(a, tpk.NSquared) == nil || b.ModInverse(b, tpk.NSquared) == nil {
+		return fmt.Errorf("%w: node %d, not invertible", ErrInvalidPartial, pd.Index)
+	}
+	a.Mul(a, new(big.Int).Exp(c4, pd.Z, tpk.NSquared))
+	a.Mod(a, tpk.NSquared)
+	b.Mul(b, new(big.Int).Exp(tpk.V, pd.Z, tpk.NSquared))
+	b.Mod(b, tpk.NSquared)
+	if proofChallenge(a, b, c4, ci2, tpk.V, vi).Cmp(pd.E) != 0 {
+		return fmt.Errorf("%w: node %d, proof does not verify", ErrInvalidPartial, pd.Index)
+	}
+	return nil
+}
+
+// Combine verifies the partial decryptions and decrypts cipher from the first
+// t valid ones of distinct nodes. Invalid partial decryptions are skipped,
+// the call only fails if less than t valid ones remain. The partial
+// decryptions of an Unverifiable key are refused.
+func Combine(tpk *ThresholdPublicKey, cipher Ciphertext, partials []*PartialDecryption) (*big.Int, error) {
+	if err := tpk.ValidateCiphertext(cipher); err != nil {
+		return nil, err
+	}
+	var used []*PartialDecryption
+	var invalid error
+	seen := make(map[int]bool)
+	for _, pd := range partials {
+		if len(used) == tpk.T {
+			break
+		}
+		if pd == nil || seen[pd.Index] {
+			continue
+		}
+		if err := VerifyPartialDecryption(tpk, cipher, pd); err != nil {
+			if invalid == nil {
+				invalid = err
+			}
+			continue
+		}
+		seen[pd.Index] = true
+		used = append(used, pd)
+	}
+	if len(used) < tpk.T {
+		if errors.Is(invalid, ErrUnverifiablePartial) {
+			return nil, invalid
+		}
+		if invalid != nil {
+			return nil, fmt.Errorf("%w: got %d valid of %d, %v", ErrNotEnoughShares, len(used), tpk.T, invalid)
+		}
+		return nil, fmt.Errorf("%w: got %d of %d", ErrNotEnoughShares, len(used), tpk.T)
+	}
+	return tpk.combine(used)
+}
+
+// CombineUnverified decrypts cipher from the first t partial decryptions of
+// distinct nodes without checking their proofs. It is meant for the keys of
+// GenerateDistributedKey and only sound if every node is honest, a single
+// wrong partial decryption silently yields a wrong plaintext.
+func CombineUnverified(tpk *ThresholdPublicKey, cipher Ciphertext, partials []*PartialDecryption) (*big.Int, error) {
+	if err := tpk.ValidateCiphertext(cipher); err != nil {
+		return nil, err
+	}
+	var used []*PartialDecryption
+	seen := make(map[int]bool)
+	for _, pd := range partials {
+		if len(used) == tpk.T {
+			break
+		}
+		if pd == nil || seen[pd.Index] {
+			continue
+		}
+		if pd.Index < 1 || pd.Index > tpk.L || pd.C == nil || pd.C.Sign() <= 0 || pd.C.Cmp(tpk.NSquared) >= 0 {
+			return nil, fmt.Errorf("%w: node %d, malformed", ErrInvalidPartial, pd.Index)
+		}
+		seen[pd.Index] = true
+		used = append(used, pd)
+	}
+	if len(used) < tpk.T {
+		return nil, fmt.Errorf("%w: got %d of %d", ErrNotEnoughShares, len(used), tpk.T)
+	}
+	return tpk.combine(used)
+}
+
+// combine interpolates the t partial decryptions of distinct nodes
+func (tpk *ThresholdPublicKey) combine(used []*PartialDecryption) (*big.Int, error) {
+	// c' = prod c_i^{2*mu_i}, mu_i = Delta * prod_{j != i} j / (j - i)
+	delta := tpk.delta()
+	res := big.NewInt(1)
+	for _, pi := range used {
+		num := new(big.Int).Set(delta)
+		den := big.NewInt(1)
+		for _, pj := range used {
+			if pj.Index == pi.Index {
+				continue
+			}
+			num.Mul(num, big.NewInt(int64(pj.Index)))
+			den.Mul(den, big.NewInt(int64(pj.Index-pi.Index)))
+		}
+		mu := num.Quo(num, den)
+		mu.Lsh(mu, 1)
+		base := pi.C
+		if mu.Sign() < 0 {
+			base = new(big.Int).ModInverse(pi.C, tpk.NSquared)
+			mu.Neg(mu)
+		}
+		res.Mul(res, new(big.Int).Exp(base, mu, tpk.NSquared))
+		res.Mod(res, tpk.NSquared)
+	}
+	// m = L(c') * (4*Delta^2)^-1 mod n
+	res.Sub(res, one)
+	res.Div(res, tpk.N)
+	inv := new(big.Int).Mul(delta, delta)
+	inv.Lsh(inv, 2)
+	if inv.ModInverse(inv, tpk.N) == nil {
+		return nil, fmt.Errorf("%w: 4*Delta^2 not invertible mod n", ErrInvalidPublicKey)
+	}
+	res.Mul(res, inv)
+	return res.Mod(res, tpk.N), nil
+}
+
+// String returns n, t, l, v and the verification keys in hex separated by
+// ':', followed by 1 for an Unverifiable key.
+func (tpk *ThresholdPublicKey) String() string {
+	parts := []string{tpk.N.Text(16), strconv.FormatInt(int64(tpk.T), 16), strconv.FormatInt(int64(tpk.L), 16), tpk.V.Text(16)}
+	for _, vk := range tpk.VerificationKeys {
+		parts = append(parts, vk.Text(16))
+	}
+	if tpk.Unverifiable {
+		parts = append(parts, "1")
+	}
+	return strings.Join(parts, ":")
+}
+
+// ParseThresholdPublicKey parses the String of a threshold public key.
+func ParseThresholdPublicKey(s string) (*ThresholdPublicKey, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 4 {
+		return nil, fmt.Errorf("%w: expect n:t:l:v:verification keys", ErrInvalidPublicKey)
+	}
+	ints, err := parseHexInts(parts, ErrInvalidPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkModulus(ints[0]); err != nil {
+		return nil, err
+	}
+	t, l := ints[1], ints[2]
+	if !t.IsInt64() || !l.IsInt64() || t.Sign() <= 0 || t.Cmp(l) > 0 || l.Int64() > maxShares {
+		return nil, fmt.Errorf("%w: invalid threshold parameters", ErrInvalidPublicKey)
+	}
+	unverifiable := int64(len(parts)) == 5+l.Int64()
+	if (unverifiable && ints[len(ints)-1].Cmp(one) != 0) || (!unverifiable && int64(len(parts)) != 4+l.Int64()) {
+		return nil, fmt.Errorf("%w: invalid threshold parameters", ErrInvalidPublicKey)
+	}
+	return &ThresholdPublicKey{
+		PublicKey:        *NewPublicKey(ints[0]),
+		T:                int(t.Int64()),
+		L:                int(l.Int64()),
+		V:                ints[3],
+		VerificationKeys: ints[4 : 4+l.Int64()],
+		Unverifiable:     unverifiable,
+	}, nil
+}
+
+// String returns the index and the share in hex separated by ':'.
+func (ks *KeyShare) String() string {
+	return strconv.FormatInt(int64(ks.Index), 16) + ":" + ks.S.Text(16)
+}
+
+// ParseKeyShare parses the String of a key share.
+func ParseKeyShare(s string) (*KeyShare, error) {
+	ints, err := parseHexInts(strings.Split(s, ":"), ErrInvalidShare)
+	if err != nil {
+		return nil, err
+	}
+	if len(ints) != 2 || !ints[0].IsInt64() || ints[0].Int64() > maxShares {
+		return nil, fmt.Errorf("%w: expect index:share", ErrInvalidShare)
+	}
+	return &KeyShare{Index: int(ints[0].Int64()), S: ints[1]}, nil
+}
+
+// String returns the index, c_i and the proof in hex separated by ':'.
+func (pd *PartialDecryption) String() string {
+	return strings.Join([]string{strconv.FormatInt(int64(pd.Index), 16), pd.C.Text(16), pd.E.Text(16), pd.Z.Text(16)}, ":")
+}
+
+// ParsePartialDecryption parses the String of a partial decryption.
+func ParsePartialDecryption(s string) (*PartialDecryption, error) {
+	ints, err := parseHexInts(strings.Split(s, ":"), ErrInvalidPartial)
+	if err != nil {
+		return nil, err
+	}
+	if len(ints) != 4 || !ints[0].IsInt64() || ints[0].Int64() > maxShares {
+		return nil, fmt.Errorf("%w: expect index:c:e:z", ErrInvalidPartial)
+	}
+	return &PartialDecryption{Index: int(ints[0].Int64()), C: ints[1], E: ints[2], Z: ints[3]}, nil
+}
+
+// parseHexInts parses non-negative hex integers, failing with errKind
+func parseHexInts(parts []string, errKind error) ([]*big.Int, error) {
+	ints := make([]*big.Int, len(parts))
+	for i, p := range parts {
+		v, ok := new(big.Int).SetString(p, 16)
+		if !ok || v.Sign() < 0 {
+			return nil, fmt.Errorf("%w: invalid hex", errKind)
+		}
+		ints[i] = v
+	}
+	return ints, nil
+}
@@ -0,0 +1,122 @@
+package pailliersdk
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestThreshold(t *testing.T) {
+	tpk, shares, err := GenerateThresholdKey(512, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := big.NewInt(123456789)
+	c, err := EncryptBig(&tpk.PublicKey, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	partials := make([]*PartialDecryption, len(shares))
+	for i, share := range shares {
+		if partials[i], err = PartialDecrypt(tpk, share, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// any t shares decrypt, in any order
+	for _, set := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}} {
+		var ps []*PartialDecryption
+		for _, i := range set {
+			ps = append(ps, partials[i])
+		}
+		res, err := Combine(tpk, c, ps)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Cmp(m) != 0 {
+			t.Fatalf("shares %v: decrypted %s, expect %s", set, res, m)
+		}
+	}
+	if _, err := Combine(tpk, c, []*PartialDecryption{partials[0], partials[1], partials[1]}); !errors.Is(err, ErrNotEnoughShares) {
+		t.Fatalf("expect ErrNotEnoughShares, got %v", err)
+	}
+
+	// a partial decryption with a wrong share or of another ciphertext fails its proof
+	forged, err := PartialDecrypt(tpk, &KeyShare{Index: 2, S: shares[0].S}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPartialDecryption(tpk, c, forged); !errors.Is(err, ErrInvalidPartial) {
+		t.Fatalf("expect ErrInvalidPartial, got %v", err)
+	}
+	c2, _ := EncryptBig(&tpk.PublicKey, m)
+	if err := VerifyPartialDecryption(tpk, c2, partials[0]); !errors.Is(err, ErrInvalidPartial) {
+		t.Fatalf("expect ErrInvalidPartial, got %v", err)
+	}
+	// invalid partial decryptions are skipped, t valid ones still decrypt
+	for _, ps := range [][]*PartialDecryption{
+		{forged, partials[2], partials[3], partials[4]},
+		{partials[2], forged, partials[3], partials[1]},
+	} {
+		if res, err := Combine(tpk, c, ps); err != nil || res.Cmp(m) != 0 {
+			t.Fatalf("decrypted %v, %v, expect %s", res, err, m)
+		}
+	}
+	if _, err := Combine(tpk, c, []*PartialDecryption{forged, partials[2], partials[3]}); !errors.Is(err, ErrNotEnoughShares) {
+		t.Fatalf("expect ErrNotEnoughShares, got %v", err)
+	}
+
+	// everything round trips through its string form
+	tpk2, err := ParseThresholdPublicKey(tpk.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	share, err := ParseKeyShare(shares[3].String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pd, err := PartialDecrypt(tpk2, share, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pd, err = ParsePartialDecryption(pd.String()); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := Combine(tpk2, c, []*PartialDecryption{partials[0], pd, partials[4]}); err != nil || res.Cmp(m) != 0 {
+		t.Fatalf("decrypted %v, %v, expect %s", res, err, m)
+	}
+
+	for _, tl := range [][2]int{{0, 3}, {4, 3}, {2, 256}} {
+		if _, _, err := GenerateThresholdKey(512, tl[0], tl[1]); !errors.Is(err, ErrInvalidShare) {
+			t.Fatalf("t %d l %d: expect ErrInvalidShare, got %v", tl[0], tl[1], err)
+		}
+	}
+
+	// keys of other primes or without their primes are not dealt
+	sk, err := GenerateKey(512)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := DealShares(sk, 3, 5); !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Fatalf("expect ErrInvalidPrivateKey, got %v", err)
+	}
+	if _, _, err := DealShares(NewPrivateKey(&sk.PublicKey, sk.Lambda), 3, 5); !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Fatalf("expect ErrInvalidPrivateKey, got %v", err)
+	}
+}
+
+func TestThresholdSingleShare(t *testing.T) {
+	tpk, shares, err := GenerateThresholdKey(512, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := big.NewInt(42)
+	c, _ := EncryptBig(&tpk.PublicKey, m)
+	pd, err := PartialDecrypt(tpk, shares[0], c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, err := Combine(tpk, c, []*PartialDecryption{pd}); err != nil || res.Cmp(m) != 0 {
+		t.Fatalf("decrypted %v, %v, expect %s", res, err, m)
+	}
+}
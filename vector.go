@@ -0,0 +1,148 @@
+package pailliersdk
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// EncryptedVector is a vector of ciphertexts under one public key, the
+// elements are signed integers. The exponentiations of Encrypt, Decrypt,
+// MulScalar and Dot run in parallel as in EncryptBatch.
+type EncryptedVector struct {
+	PublicKey *PublicKey
+	Elements  []Ciphertext
+}
+
+var errLengthMismatch = errors.New("vectors have different lengths")
+
+// EncryptVector encrypts the signed values.
+func EncryptVector(ctx context.Context, pk *PublicKey, values []*big.Int, opts ...BatchOption) (*EncryptedVector, error) {
+	msgs := make([]*big.Int, len(values))
+	for i, v := range values {
+		m, err := pk.EncodeSigned(v)
+		if err != nil {
+			return nil, fmt.Errorf("value %d: %w", i, err)
+		}
+		msgs[i] = m
+	}
+	elems, err := EncryptBatch(ctx, pk, msgs, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedVector{PublicKey: pk, Elements: elems}, nil
+}
+
+// Len returns the number of elements.
+func (v *EncryptedVector) Len() int {
+	return len(v.Elements)
+}
+
+// Decrypt decrypts the signed values.
+func (v *EncryptedVector) Decrypt(ctx context.Context, sk *PrivateKey, opts ...BatchOption) ([]*big.Int, error) {
+	values, err := DecryptBatch(ctx, sk, v.Elements, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for i, m := range values {
+		values[i] = sk.DecodeSigned(m)
+	}
+	return values, nil
+}
+
+// Add returns the element-wise sum, both vectors must be under the same
+// public key.
+func (v *EncryptedVector) Add(w *EncryptedVector) (*EncryptedVector, error) {
+	if v.PublicKey.N.Cmp(w.PublicKey.N) != 0 {
+		return nil, fmt.Errorf("%w: vectors under different public keys", ErrInvalidPublicKey)
+	}
+	if v.Len() != w.Len() {
+		return nil, errLengthMismatch
+	}
+	res := make([]Ciphertext, v.Len())
+	for i := range res {
+		var err error
+		if res[i], err = v.PublicKey.Add(v.Elements[i], w.Elements[i]); err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	return &EncryptedVector{PublicKey: v.PublicKey, Elements: res}, nil
+}
+
+// MulScalar multiplies every element by the signed scalar k.
+func (v *EncryptedVector) MulScalar(ctx context.Context, k *big.Int, opts ...BatchOption) (*EncryptedVector, error) {
+	res := make([]Ciphertext, v.Len())
+	err := runBatch(ctx, v.Len(), newBatchConfig(opts).workers, func(i int) error {
+		var err error
+		res[i], err = MulScalarSigned(v.PublicKey, v.Elements[i], k)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedVector{PublicKey: v.PublicKey, Elements: res}, nil
+}
+
+// Dot returns a ciphertext of the inner product with the signed plaintext
+// weights, prod c_i^{w_i}.
+func (v *EncryptedVector) Dot(ctx context.Context, weights []*big.Int, opts ...BatchOption) (Ciphertext, error) {
+	if v.Len() != len(weights) {
+		return Ciphertext{}, errLengthMismatch
+	}
+	terms := make([]Ciphertext, v.Len())
+	err := runBatch(ctx, v.Len(), newBatchConfig(opts).workers, func(i int) error {
+		var err error
+		terms[i], err = MulScalarSigned(v.PublicKey, v.Elements[i], weights[i])
+		return err
+	})
+	if err != nil {
+		return Ciphertext{}, err
+	}
+	return Sum(v.PublicKey, terms, opts...)
+}
+
+// Sum returns a ciphertext of the sum of the elements, an encryption of zero
+// for an empty vector.
+func (v *EncryptedVector) Sum(opts ...BatchOption) (Ciphertext, error) {
+	return Sum(v.PublicKey, v.Elements, opts...)
+}
+
+// Bytes returns the elements back to back, each padded to the ciphertext length.
+func (v *EncryptedVector) Bytes() []byte {
+	size := v.PublicKey.ciphertextLen()
+	b := make([]byte, 0, size*v.Len())
+	for _, c := range v.Elements {
+		b = append(b, padBytes(c.C.Bytes(), size)...)
+	}
+	return b
+}
+
+// String returns the base64 encoded Bytes, a third shorter than hex.
+func (v *EncryptedVector) String() string {
+	return base64.RawStdEncoding.EncodeToString(v.Bytes())
+}
+
+// ParseEncryptedVector parses the String of a vector under the given public key.
+func ParseEncryptedVector(pk *PublicKey, s string) (*EncryptedVector, error) {
+	if pk == nil {
+		return nil, ErrInvalidPublicKey
+	}
+	b, err := base64.RawStdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid base64", ErrInvalidCiphertext)
+	}
+	size := pk.ciphertextLen()
+	if len(b)%size != 0 {
+		return nil, fmt.Errorf("%w: vector of %d bytes is not a multiple of %d", ErrInvalidCiphertext, len(b), size)
+	}
+	elems := make([]Ciphertext, len(b)/size)
+	for i := range elems {
+		elems[i] = pk.NewCiphertext(new(big.Int).SetBytes(b[i*size : (i+1)*size]))
+		if err := pk.ValidateCiphertext(elems[i]); err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	return &EncryptedVector{PublicKey: pk, Elements: elems}, nil
+}
@@ -0,0 +1,91 @@
+package pailliersdk
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func bigInts(values ...int64) []*big.Int {
+	res := make([]*big.Int, len(values))
+	for i, v := range values {
+		res[i] = big.NewInt(v)
+	}
+	return res
+}
+
+func TestEncryptedVector(t *testing.T) {
+	sk, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &sk.PublicKey
+	ctx := context.Background()
+
+	x, err := EncryptVector(ctx, pk, bigInts(1, -2, 3, 40), WithBatchWorkers(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := EncryptVector(ctx, pk, bigInts(10, 20, -30, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// serialization round trip
+	x, err = ParseEncryptedVector(pk, x.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(x.Bytes()) != 4*pk.ciphertextLen() {
+		t.Fatalf("vector of %d bytes, expect %d", len(x.Bytes()), 4*pk.ciphertextLen())
+	}
+
+	sum, err := x.Add(y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scaled, err := sum.MulScalar(ctx, big.NewInt(-3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := scaled.Decrypt(ctx, sk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, expect := range []int64{-33, -54, 81, -120} {
+		if res[i].Int64() != expect {
+			t.Fatalf("element %d is %s, expect %d", i, res[i], expect)
+		}
+	}
+
+	dot, err := x.Dot(ctx, bigInts(2, 3, -1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m, _ := DecryptSigned(sk, dot); m.Int64() != 2-6-3+40 {
+		t.Fatalf("dot product %s, expect %d", m, 2-6-3+40)
+	}
+	total, err := y.Sum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m, _ := DecryptSigned(sk, total); m.Int64() != 0 {
+		t.Fatalf("sum %s, expect 0", m)
+	}
+
+	if _, err := x.Add(&EncryptedVector{PublicKey: pk}); err == nil {
+		t.Fatal("vectors of different lengths should be rejected")
+	}
+	other, err := GenerateKey(testBit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := &EncryptedVector{PublicKey: &other.PublicKey, Elements: x.Elements}
+	if _, err := x.Add(z); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Fatalf("vectors under different keys, expect ErrInvalidPublicKey, got %v", err)
+	}
+	if _, err := ParseEncryptedVector(pk, x.String()[:10]); err == nil {
+		t.Fatal("truncated vector should be rejected")
+	}
+}
@@ -36,7 +36,15 @@ func Init(confPath string) error {
 		return err
 	}
 	pconfig = cfg
-	client = pailliersdk.NewPaillierClient()
+	opts := []pailliersdk.ClientOption{pailliersdk.WithRequireProofs(cfg.RequireProofs)}
+	if cfg.Backend != "" {
+		b, ok := pailliersdk.LookupBackend(cfg.Backend)
+		if !ok {
+			return fmt.Errorf("unknown paillier backend %q", cfg.Backend)
+		}
+		opts = append(opts, pailliersdk.WithBackend(b))
+	}
+	client = pailliersdk.NewPaillierClient(opts...)
 	return nil
 }
 
@@ -45,7 +53,7 @@ func Run(requestBuf []byte) ([]byte, error) {
 		err       error
 		tmpbuf    []byte
 		tmpbufstr string
-		plainMap  map[string]string
+		plainMap  map[string]json.RawMessage
 	)
 	in := &pb.TrustFunctionCallRequest{}
 	if err = proto.Unmarshal(requestBuf, in); err != nil {
@@ -70,7 +78,12 @@ func Run(requestBuf []byte) ([]byte, error) {
 	kvs := &pb.TrustFunctionCallResponse_Kvs{
 		Kvs: &pb.KVPairs{},
 	}
-	for k, v := range plainMap {
+	for k, raw := range plainMap {
+		// strings are passed as is, repeated fields keep their json encoding
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			v = string(raw)
+		}
 		kvs.Kvs.Kv = append(kvs.Kvs.Kv, &pb.KVPair{Key: k, Value: v})
 	}
 	return proto.Marshal(&pb.TrustFunctionCallResponse{Results: kvs})
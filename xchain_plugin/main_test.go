@@ -1,7 +1,19 @@
 package main
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
 	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/hongyanwang/pailliersdk"
+	"github.com/hongyanwang/pailliersdk/xchain_plugin/pb"
 )
 
 func TestInit(t *testing.T) {
@@ -10,3 +22,86 @@ func TestInit(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func run(t *testing.T, method string, args interface{}) map[string]string {
+	data, _ := json.Marshal(args)
+	req, _ := proto.Marshal(&pb.TrustFunctionCallRequest{Method: method, Args: string(data)})
+	res, err := Run(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp pb.TrustFunctionCallResponse
+	if err := proto.Unmarshal(res, &resp); err != nil {
+		t.Fatal(err)
+	}
+	kvs := make(map[string]string)
+	for _, kv := range resp.GetKvs().Kv {
+		kvs[kv.Key] = kv.Value
+	}
+	return kvs
+}
+
+func TestRunBatch(t *testing.T) {
+	if err := Init("./paillierconfig.conf"); err != nil {
+		t.Fatal(err)
+	}
+	keys := run(t, "PaillierKeyGen", map[string]int{"secbit": 512})
+	enc := run(t, "PaillierEncBatch", map[string]interface{}{
+		"publicKey": keys["publicKey"],
+		"messages":  []string{"3", "5"},
+	})
+	// repeated fields keep their json encoding
+	var ciphers []string
+	if err := json.Unmarshal([]byte(enc["ciphertexts"]), &ciphers); err != nil {
+		t.Fatal(err)
+	}
+	dec := run(t, "PaillierDecBatch", map[string]interface{}{
+		"publicKey":   keys["publicKey"],
+		"privateKey":  keys["privateKey"],
+		"ciphertexts": ciphers,
+	})
+	if dec["plaintexts"] != `["3","5"]` {
+		t.Fatalf("decrypted %s, expect [\"3\",\"5\"]", dec["plaintexts"])
+	}
+}
+
+func TestInitRequireProofs(t *testing.T) {
+	// no backend, the default one is used with proofs required
+	f, err := ioutil.TempFile("", "paillierconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString("enable: on\nrequireProofs: true\n"); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	if err := Init(f.Name()); err != nil {
+		t.Fatal(err)
+	}
+	defer Init("./paillierconfig.conf")
+
+	keys := run(t, "PaillierKeyGen", map[string]int{"secbit": 512})
+	enc := run(t, "PaillierEnc", map[string]string{"publicKey": keys["publicKey"], "message": "3"})
+	ecdsaPrvkey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	const address = "ZsPy7eELS55MXALUhAynUtjsxjeKFbwqy"
+	exp := map[string]string{
+		"publicKey":  keys["publicKey"],
+		"ciphertext": enc["ciphertext"],
+		"commitment": pailliersdk.Commit(ecdsaPrvkey, enc["ciphertext"], address),
+		"scalar":     "2",
+	}
+	submit := func() error {
+		data, _ := json.Marshal(exp)
+		req, _ := proto.Marshal(&pb.TrustFunctionCallRequest{Method: "PaillierExp", Args: string(data), Address: address})
+		_, err := Run(req)
+		return err
+	}
+	if err := submit(); !errors.Is(err, pailliersdk.ErrInvalidProof) {
+		t.Fatalf("expect ErrInvalidProof without a proof, got %v", err)
+	}
+	exp["proof"] = enc["proof"]
+	if err := submit(); err != nil {
+		t.Fatal(err)
+	}
+}
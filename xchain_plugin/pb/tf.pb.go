@@ -394,8 +394,13 @@ func (m *KeyGenOutputs) GetPublicKey() string {
 }
 
 type PaillierEncParams struct {
-	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	PublicKey            string   `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Message   string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	PublicKey string `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	// message is a signed integer and may carry a leading minus sign
+	Signed bool `protobuf:"varint,3,opt,name=signed,proto3" json:"signed,omitempty"`
+	// return a proof of knowledge of the plaintext, always returned if the
+	// node is configured with requireProofs
+	WithProof            bool     `protobuf:"varint,4,opt,name=withProof,proto3" json:"withProof,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -440,8 +445,24 @@ func (m *PaillierEncParams) GetPublicKey() string {
 	return ""
 }
 
+func (m *PaillierEncParams) GetSigned() bool {
+	if m != nil {
+		return m.Signed
+	}
+	return false
+}
+
+func (m *PaillierEncParams) GetWithProof() bool {
+	if m != nil {
+		return m.WithProof
+	}
+	return false
+}
+
 type PaillierEncOutputs struct {
-	Ciphertext           string   `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	Ciphertext string `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	// proof of knowledge of the plaintext if requested, see VerifyEncryption
+	Proof                string   `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -479,10 +500,19 @@ func (m *PaillierEncOutputs) GetCiphertext() string {
 	return ""
 }
 
+func (m *PaillierEncOutputs) GetProof() string {
+	if m != nil {
+		return m.Proof
+	}
+	return ""
+}
+
 type PaillierDecParams struct {
-	Ciphertext           string   `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
-	PublicKey            string   `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
-	PrivateKey           string   `protobuf:"bytes,3,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	Ciphertext string `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	PublicKey  string `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	PrivateKey string `protobuf:"bytes,3,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	// decode the plaintext as a signed integer
+	Signed               bool     `protobuf:"varint,4,opt,name=signed,proto3" json:"signed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -534,8 +564,21 @@ func (m *PaillierDecParams) GetPrivateKey() string {
 	return ""
 }
 
+func (m *PaillierDecParams) GetSigned() bool {
+	if m != nil {
+		return m.Signed
+	}
+	return false
+}
+
 type PaillierDecOutputs struct {
-	Plaintext            uint64   `protobuf:"varint,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	// the plaintext if it fits in 64 bits, 0 for a larger or a negative
+	// plaintext which only plaintextDecimal carries
+	Plaintext uint64 `protobuf:"varint,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	// proof that the ciphertext decrypts to the plaintext, see VerifyDecryption
+	Proof string `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// the full plaintext in decimal, signed if requested
+	PlaintextDecimal     string   `protobuf:"bytes,3,opt,name=plaintextDecimal,proto3" json:"plaintextDecimal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -573,12 +616,30 @@ func (m *PaillierDecOutputs) GetPlaintext() uint64 {
 	return 0
 }
 
+func (m *PaillierDecOutputs) GetProof() string {
+	if m != nil {
+		return m.Proof
+	}
+	return ""
+}
+
+func (m *PaillierDecOutputs) GetPlaintextDecimal() string {
+	if m != nil {
+		return m.PlaintextDecimal
+	}
+	return ""
+}
+
 type PaillierMulParams struct {
-	PublicKey            string   `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
-	Ciphertext1          string   `protobuf:"bytes,2,opt,name=ciphertext1,proto3" json:"ciphertext1,omitempty"`
-	Ciphertext2          string   `protobuf:"bytes,3,opt,name=ciphertext2,proto3" json:"ciphertext2,omitempty"`
-	Commitment1          string   `protobuf:"bytes,4,opt,name=commitment1,proto3" json:"commitment1,omitempty"`
-	Commitment2          string   `protobuf:"bytes,5,opt,name=commitment2,proto3" json:"commitment2,omitempty"`
+	PublicKey   string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Ciphertext1 string `protobuf:"bytes,2,opt,name=ciphertext1,proto3" json:"ciphertext1,omitempty"`
+	Ciphertext2 string `protobuf:"bytes,3,opt,name=ciphertext2,proto3" json:"ciphertext2,omitempty"`
+	Commitment1 string `protobuf:"bytes,4,opt,name=commitment1,proto3" json:"commitment1,omitempty"`
+	Commitment2 string `protobuf:"bytes,5,opt,name=commitment2,proto3" json:"commitment2,omitempty"`
+	// optional proofs of knowledge of the plaintexts, required if the node
+	// is configured with requireProofs
+	Proof1               string   `protobuf:"bytes,6,opt,name=proof1,proto3" json:"proof1,omitempty"`
+	Proof2               string   `protobuf:"bytes,7,opt,name=proof2,proto3" json:"proof2,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -644,6 +705,20 @@ func (m *PaillierMulParams) GetCommitment2() string {
 	return ""
 }
 
+func (m *PaillierMulParams) GetProof1() string {
+	if m != nil {
+		return m.Proof1
+	}
+	return ""
+}
+
+func (m *PaillierMulParams) GetProof2() string {
+	if m != nil {
+		return m.Proof2
+	}
+	return ""
+}
+
 type PaillierMulOutputs struct {
 	Ciphertext           string   `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -684,10 +759,15 @@ func (m *PaillierMulOutputs) GetCiphertext() string {
 }
 
 type PaillierExpParams struct {
-	PublicKey            string   `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
-	Ciphertext           string   `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
-	Commitment           string   `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
-	Scalar               string   `protobuf:"bytes,4,opt,name=scalar,proto3" json:"scalar,omitempty"`
+	PublicKey  string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Ciphertext string `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	Commitment string `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Scalar     string `protobuf:"bytes,4,opt,name=scalar,proto3" json:"scalar,omitempty"`
+	// scalar is a signed integer and may carry a leading minus sign
+	Signed bool `protobuf:"varint,5,opt,name=signed,proto3" json:"signed,omitempty"`
+	// optional proof of knowledge of the plaintext, required if the node is
+	// configured with requireProofs
+	Proof                string   `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -746,6 +826,20 @@ func (m *PaillierExpParams) GetScalar() string {
 	return ""
 }
 
+func (m *PaillierExpParams) GetSigned() bool {
+	if m != nil {
+		return m.Signed
+	}
+	return false
+}
+
+func (m *PaillierExpParams) GetProof() string {
+	if m != nil {
+		return m.Proof
+	}
+	return ""
+}
+
 type PaillierExpOutputs struct {
 	Ciphertext           string   `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -785,6 +879,2314 @@ func (m *PaillierExpOutputs) GetCiphertext() string {
 	return ""
 }
 
+type PaillierSubParams struct {
+	PublicKey   string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Ciphertext1 string `protobuf:"bytes,2,opt,name=ciphertext1,proto3" json:"ciphertext1,omitempty"`
+	Ciphertext2 string `protobuf:"bytes,3,opt,name=ciphertext2,proto3" json:"ciphertext2,omitempty"`
+	Commitment1 string `protobuf:"bytes,4,opt,name=commitment1,proto3" json:"commitment1,omitempty"`
+	Commitment2 string `protobuf:"bytes,5,opt,name=commitment2,proto3" json:"commitment2,omitempty"`
+	// optional proofs of knowledge of the plaintexts, required if the node
+	// is configured with requireProofs
+	Proof1               string   `protobuf:"bytes,6,opt,name=proof1,proto3" json:"proof1,omitempty"`
+	Proof2               string   `protobuf:"bytes,7,opt,name=proof2,proto3" json:"proof2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierSubParams) Reset()         { *m = PaillierSubParams{} }
+func (m *PaillierSubParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierSubParams) ProtoMessage()    {}
+func (*PaillierSubParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{15}
+}
+
+func (m *PaillierSubParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierSubParams.Unmarshal(m, b)
+}
+func (m *PaillierSubParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierSubParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierSubParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierSubParams.Merge(m, src)
+}
+func (m *PaillierSubParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierSubParams.Size(m)
+}
+func (m *PaillierSubParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierSubParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierSubParams proto.InternalMessageInfo
+
+func (m *PaillierSubParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierSubParams) GetCiphertext1() string {
+	if m != nil {
+		return m.Ciphertext1
+	}
+	return ""
+}
+
+func (m *PaillierSubParams) GetCiphertext2() string {
+	if m != nil {
+		return m.Ciphertext2
+	}
+	return ""
+}
+
+func (m *PaillierSubParams) GetCommitment1() string {
+	if m != nil {
+		return m.Commitment1
+	}
+	return ""
+}
+
+func (m *PaillierSubParams) GetCommitment2() string {
+	if m != nil {
+		return m.Commitment2
+	}
+	return ""
+}
+
+func (m *PaillierSubParams) GetProof1() string {
+	if m != nil {
+		return m.Proof1
+	}
+	return ""
+}
+
+func (m *PaillierSubParams) GetProof2() string {
+	if m != nil {
+		return m.Proof2
+	}
+	return ""
+}
+
+type PaillierSubOutputs struct {
+	Ciphertext           string   `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierSubOutputs) Reset()         { *m = PaillierSubOutputs{} }
+func (m *PaillierSubOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierSubOutputs) ProtoMessage()    {}
+func (*PaillierSubOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{16}
+}
+
+func (m *PaillierSubOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierSubOutputs.Unmarshal(m, b)
+}
+func (m *PaillierSubOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierSubOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierSubOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierSubOutputs.Merge(m, src)
+}
+func (m *PaillierSubOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierSubOutputs.Size(m)
+}
+func (m *PaillierSubOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierSubOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierSubOutputs proto.InternalMessageInfo
+
+func (m *PaillierSubOutputs) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+type PaillierNegParams struct {
+	PublicKey  string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Ciphertext string `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	Commitment string `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// optional proof of knowledge of the plaintext, required if the node is
+	// configured with requireProofs
+	Proof                string   `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierNegParams) Reset()         { *m = PaillierNegParams{} }
+func (m *PaillierNegParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierNegParams) ProtoMessage()    {}
+func (*PaillierNegParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{17}
+}
+
+func (m *PaillierNegParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierNegParams.Unmarshal(m, b)
+}
+func (m *PaillierNegParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierNegParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierNegParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierNegParams.Merge(m, src)
+}
+func (m *PaillierNegParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierNegParams.Size(m)
+}
+func (m *PaillierNegParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierNegParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierNegParams proto.InternalMessageInfo
+
+func (m *PaillierNegParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierNegParams) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+func (m *PaillierNegParams) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+func (m *PaillierNegParams) GetProof() string {
+	if m != nil {
+		return m.Proof
+	}
+	return ""
+}
+
+type PaillierNegOutputs struct {
+	Ciphertext           string   `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierNegOutputs) Reset()         { *m = PaillierNegOutputs{} }
+func (m *PaillierNegOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierNegOutputs) ProtoMessage()    {}
+func (*PaillierNegOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{18}
+}
+
+func (m *PaillierNegOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierNegOutputs.Unmarshal(m, b)
+}
+func (m *PaillierNegOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierNegOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierNegOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierNegOutputs.Merge(m, src)
+}
+func (m *PaillierNegOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierNegOutputs.Size(m)
+}
+func (m *PaillierNegOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierNegOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierNegOutputs proto.InternalMessageInfo
+
+func (m *PaillierNegOutputs) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+type PaillierAddPlainParams struct {
+	PublicKey  string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Ciphertext string `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	Commitment string `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Message    string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// message is a signed integer and may carry a leading minus sign
+	Signed bool `protobuf:"varint,5,opt,name=signed,proto3" json:"signed,omitempty"`
+	// optional proof of knowledge of the plaintext, required if the node is
+	// configured with requireProofs
+	Proof                string   `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierAddPlainParams) Reset()         { *m = PaillierAddPlainParams{} }
+func (m *PaillierAddPlainParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierAddPlainParams) ProtoMessage()    {}
+func (*PaillierAddPlainParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{19}
+}
+
+func (m *PaillierAddPlainParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierAddPlainParams.Unmarshal(m, b)
+}
+func (m *PaillierAddPlainParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierAddPlainParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierAddPlainParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierAddPlainParams.Merge(m, src)
+}
+func (m *PaillierAddPlainParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierAddPlainParams.Size(m)
+}
+func (m *PaillierAddPlainParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierAddPlainParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierAddPlainParams proto.InternalMessageInfo
+
+func (m *PaillierAddPlainParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierAddPlainParams) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+func (m *PaillierAddPlainParams) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+func (m *PaillierAddPlainParams) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *PaillierAddPlainParams) GetSigned() bool {
+	if m != nil {
+		return m.Signed
+	}
+	return false
+}
+
+func (m *PaillierAddPlainParams) GetProof() string {
+	if m != nil {
+		return m.Proof
+	}
+	return ""
+}
+
+type PaillierAddPlainOutputs struct {
+	Ciphertext           string   `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierAddPlainOutputs) Reset()         { *m = PaillierAddPlainOutputs{} }
+func (m *PaillierAddPlainOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierAddPlainOutputs) ProtoMessage()    {}
+func (*PaillierAddPlainOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{20}
+}
+
+func (m *PaillierAddPlainOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierAddPlainOutputs.Unmarshal(m, b)
+}
+func (m *PaillierAddPlainOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierAddPlainOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierAddPlainOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierAddPlainOutputs.Merge(m, src)
+}
+func (m *PaillierAddPlainOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierAddPlainOutputs.Size(m)
+}
+func (m *PaillierAddPlainOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierAddPlainOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierAddPlainOutputs proto.InternalMessageInfo
+
+func (m *PaillierAddPlainOutputs) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+type PaillierRerandomizeParams struct {
+	PublicKey  string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Ciphertext string `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	Commitment string `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// optional proof of knowledge of the plaintext, required if the node is
+	// configured with requireProofs
+	Proof                string   `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierRerandomizeParams) Reset()         { *m = PaillierRerandomizeParams{} }
+func (m *PaillierRerandomizeParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierRerandomizeParams) ProtoMessage()    {}
+func (*PaillierRerandomizeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{21}
+}
+
+func (m *PaillierRerandomizeParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierRerandomizeParams.Unmarshal(m, b)
+}
+func (m *PaillierRerandomizeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierRerandomizeParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierRerandomizeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierRerandomizeParams.Merge(m, src)
+}
+func (m *PaillierRerandomizeParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierRerandomizeParams.Size(m)
+}
+func (m *PaillierRerandomizeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierRerandomizeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierRerandomizeParams proto.InternalMessageInfo
+
+func (m *PaillierRerandomizeParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierRerandomizeParams) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+func (m *PaillierRerandomizeParams) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+func (m *PaillierRerandomizeParams) GetProof() string {
+	if m != nil {
+		return m.Proof
+	}
+	return ""
+}
+
+type PaillierRerandomizeOutputs struct {
+	Ciphertext           string   `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierRerandomizeOutputs) Reset()         { *m = PaillierRerandomizeOutputs{} }
+func (m *PaillierRerandomizeOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierRerandomizeOutputs) ProtoMessage()    {}
+func (*PaillierRerandomizeOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{22}
+}
+
+func (m *PaillierRerandomizeOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierRerandomizeOutputs.Unmarshal(m, b)
+}
+func (m *PaillierRerandomizeOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierRerandomizeOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierRerandomizeOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierRerandomizeOutputs.Merge(m, src)
+}
+func (m *PaillierRerandomizeOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierRerandomizeOutputs.Size(m)
+}
+func (m *PaillierRerandomizeOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierRerandomizeOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierRerandomizeOutputs proto.InternalMessageInfo
+
+func (m *PaillierRerandomizeOutputs) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+type PaillierEncBatchParams struct {
+	Messages  []string `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	PublicKey string   `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	// messages are signed integers and may carry a leading minus sign
+	Signed bool `protobuf:"varint,3,opt,name=signed,proto3" json:"signed,omitempty"`
+	// return proofs of knowledge of the plaintexts, always returned if the
+	// node is configured with requireProofs
+	WithProof            bool     `protobuf:"varint,4,opt,name=withProof,proto3" json:"withProof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierEncBatchParams) Reset()         { *m = PaillierEncBatchParams{} }
+func (m *PaillierEncBatchParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierEncBatchParams) ProtoMessage()    {}
+func (*PaillierEncBatchParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{23}
+}
+
+func (m *PaillierEncBatchParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierEncBatchParams.Unmarshal(m, b)
+}
+func (m *PaillierEncBatchParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierEncBatchParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierEncBatchParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierEncBatchParams.Merge(m, src)
+}
+func (m *PaillierEncBatchParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierEncBatchParams.Size(m)
+}
+func (m *PaillierEncBatchParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierEncBatchParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierEncBatchParams proto.InternalMessageInfo
+
+func (m *PaillierEncBatchParams) GetMessages() []string {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *PaillierEncBatchParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierEncBatchParams) GetSigned() bool {
+	if m != nil {
+		return m.Signed
+	}
+	return false
+}
+
+func (m *PaillierEncBatchParams) GetWithProof() bool {
+	if m != nil {
+		return m.WithProof
+	}
+	return false
+}
+
+type PaillierEncBatchOutputs struct {
+	Ciphertexts []string `protobuf:"bytes,1,rep,name=ciphertexts,proto3" json:"ciphertexts,omitempty"`
+	// one proof per ciphertext if requested
+	Proofs               []string `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierEncBatchOutputs) Reset()         { *m = PaillierEncBatchOutputs{} }
+func (m *PaillierEncBatchOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierEncBatchOutputs) ProtoMessage()    {}
+func (*PaillierEncBatchOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{24}
+}
+
+func (m *PaillierEncBatchOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierEncBatchOutputs.Unmarshal(m, b)
+}
+func (m *PaillierEncBatchOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierEncBatchOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierEncBatchOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierEncBatchOutputs.Merge(m, src)
+}
+func (m *PaillierEncBatchOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierEncBatchOutputs.Size(m)
+}
+func (m *PaillierEncBatchOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierEncBatchOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierEncBatchOutputs proto.InternalMessageInfo
+
+func (m *PaillierEncBatchOutputs) GetCiphertexts() []string {
+	if m != nil {
+		return m.Ciphertexts
+	}
+	return nil
+}
+
+func (m *PaillierEncBatchOutputs) GetProofs() []string {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+type PaillierDecBatchParams struct {
+	Ciphertexts []string `protobuf:"bytes,1,rep,name=ciphertexts,proto3" json:"ciphertexts,omitempty"`
+	PublicKey   string   `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	PrivateKey  string   `protobuf:"bytes,3,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	// decode plaintexts in (n/2, n) as negative integers
+	Signed               bool     `protobuf:"varint,4,opt,name=signed,proto3" json:"signed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierDecBatchParams) Reset()         { *m = PaillierDecBatchParams{} }
+func (m *PaillierDecBatchParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierDecBatchParams) ProtoMessage()    {}
+func (*PaillierDecBatchParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{25}
+}
+
+func (m *PaillierDecBatchParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierDecBatchParams.Unmarshal(m, b)
+}
+func (m *PaillierDecBatchParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierDecBatchParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierDecBatchParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierDecBatchParams.Merge(m, src)
+}
+func (m *PaillierDecBatchParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierDecBatchParams.Size(m)
+}
+func (m *PaillierDecBatchParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierDecBatchParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierDecBatchParams proto.InternalMessageInfo
+
+func (m *PaillierDecBatchParams) GetCiphertexts() []string {
+	if m != nil {
+		return m.Ciphertexts
+	}
+	return nil
+}
+
+func (m *PaillierDecBatchParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierDecBatchParams) GetPrivateKey() string {
+	if m != nil {
+		return m.PrivateKey
+	}
+	return ""
+}
+
+func (m *PaillierDecBatchParams) GetSigned() bool {
+	if m != nil {
+		return m.Signed
+	}
+	return false
+}
+
+type PaillierDecBatchOutputs struct {
+	Plaintexts           []string `protobuf:"bytes,1,rep,name=plaintexts,proto3" json:"plaintexts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierDecBatchOutputs) Reset()         { *m = PaillierDecBatchOutputs{} }
+func (m *PaillierDecBatchOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierDecBatchOutputs) ProtoMessage()    {}
+func (*PaillierDecBatchOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{26}
+}
+
+func (m *PaillierDecBatchOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierDecBatchOutputs.Unmarshal(m, b)
+}
+func (m *PaillierDecBatchOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierDecBatchOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierDecBatchOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierDecBatchOutputs.Merge(m, src)
+}
+func (m *PaillierDecBatchOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierDecBatchOutputs.Size(m)
+}
+func (m *PaillierDecBatchOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierDecBatchOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierDecBatchOutputs proto.InternalMessageInfo
+
+func (m *PaillierDecBatchOutputs) GetPlaintexts() []string {
+	if m != nil {
+		return m.Plaintexts
+	}
+	return nil
+}
+
+type PaillierEncPackedParams struct {
+	Values    []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	PublicKey string   `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	// width of the values and extra bits per slot for homomorphic operations
+	ValueBits int32 `protobuf:"varint,3,opt,name=valueBits,proto3" json:"valueBits,omitempty"`
+	Headroom  int32 `protobuf:"varint,4,opt,name=headroom,proto3" json:"headroom,omitempty"`
+	// return a proof of knowledge of the packed plaintext, always returned if
+	// the node is configured with requireProofs
+	WithProof            bool     `protobuf:"varint,5,opt,name=withProof,proto3" json:"withProof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierEncPackedParams) Reset()         { *m = PaillierEncPackedParams{} }
+func (m *PaillierEncPackedParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierEncPackedParams) ProtoMessage()    {}
+func (*PaillierEncPackedParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{27}
+}
+
+func (m *PaillierEncPackedParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierEncPackedParams.Unmarshal(m, b)
+}
+func (m *PaillierEncPackedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierEncPackedParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierEncPackedParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierEncPackedParams.Merge(m, src)
+}
+func (m *PaillierEncPackedParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierEncPackedParams.Size(m)
+}
+func (m *PaillierEncPackedParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierEncPackedParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierEncPackedParams proto.InternalMessageInfo
+
+func (m *PaillierEncPackedParams) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *PaillierEncPackedParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierEncPackedParams) GetValueBits() int32 {
+	if m != nil {
+		return m.ValueBits
+	}
+	return 0
+}
+
+func (m *PaillierEncPackedParams) GetHeadroom() int32 {
+	if m != nil {
+		return m.Headroom
+	}
+	return 0
+}
+
+func (m *PaillierEncPackedParams) GetWithProof() bool {
+	if m != nil {
+		return m.WithProof
+	}
+	return false
+}
+
+type PaillierEncPackedOutputs struct {
+	Ciphertext string `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	// number of slots of the plaintext
+	Slots int32 `protobuf:"varint,2,opt,name=slots,proto3" json:"slots,omitempty"`
+	// proof of knowledge of the packed plaintext if requested
+	Proof                string   `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierEncPackedOutputs) Reset()         { *m = PaillierEncPackedOutputs{} }
+func (m *PaillierEncPackedOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierEncPackedOutputs) ProtoMessage()    {}
+func (*PaillierEncPackedOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{28}
+}
+
+func (m *PaillierEncPackedOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierEncPackedOutputs.Unmarshal(m, b)
+}
+func (m *PaillierEncPackedOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierEncPackedOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierEncPackedOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierEncPackedOutputs.Merge(m, src)
+}
+func (m *PaillierEncPackedOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierEncPackedOutputs.Size(m)
+}
+func (m *PaillierEncPackedOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierEncPackedOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierEncPackedOutputs proto.InternalMessageInfo
+
+func (m *PaillierEncPackedOutputs) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+func (m *PaillierEncPackedOutputs) GetSlots() int32 {
+	if m != nil {
+		return m.Slots
+	}
+	return 0
+}
+
+func (m *PaillierEncPackedOutputs) GetProof() string {
+	if m != nil {
+		return m.Proof
+	}
+	return ""
+}
+
+type PaillierDecPackedParams struct {
+	Ciphertext string `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	PublicKey  string `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	PrivateKey string `protobuf:"bytes,3,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	ValueBits  int32  `protobuf:"varint,4,opt,name=valueBits,proto3" json:"valueBits,omitempty"`
+	Headroom   int32  `protobuf:"varint,5,opt,name=headroom,proto3" json:"headroom,omitempty"`
+	// number of leading slots to return, all slots if zero
+	Count                int32    `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierDecPackedParams) Reset()         { *m = PaillierDecPackedParams{} }
+func (m *PaillierDecPackedParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierDecPackedParams) ProtoMessage()    {}
+func (*PaillierDecPackedParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{29}
+}
+
+func (m *PaillierDecPackedParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierDecPackedParams.Unmarshal(m, b)
+}
+func (m *PaillierDecPackedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierDecPackedParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierDecPackedParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierDecPackedParams.Merge(m, src)
+}
+func (m *PaillierDecPackedParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierDecPackedParams.Size(m)
+}
+func (m *PaillierDecPackedParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierDecPackedParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierDecPackedParams proto.InternalMessageInfo
+
+func (m *PaillierDecPackedParams) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+func (m *PaillierDecPackedParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierDecPackedParams) GetPrivateKey() string {
+	if m != nil {
+		return m.PrivateKey
+	}
+	return ""
+}
+
+func (m *PaillierDecPackedParams) GetValueBits() int32 {
+	if m != nil {
+		return m.ValueBits
+	}
+	return 0
+}
+
+func (m *PaillierDecPackedParams) GetHeadroom() int32 {
+	if m != nil {
+		return m.Headroom
+	}
+	return 0
+}
+
+func (m *PaillierDecPackedParams) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type PaillierDecPackedOutputs struct {
+	Values               []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierDecPackedOutputs) Reset()         { *m = PaillierDecPackedOutputs{} }
+func (m *PaillierDecPackedOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierDecPackedOutputs) ProtoMessage()    {}
+func (*PaillierDecPackedOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{30}
+}
+
+func (m *PaillierDecPackedOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierDecPackedOutputs.Unmarshal(m, b)
+}
+func (m *PaillierDecPackedOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierDecPackedOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierDecPackedOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierDecPackedOutputs.Merge(m, src)
+}
+func (m *PaillierDecPackedOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierDecPackedOutputs.Size(m)
+}
+func (m *PaillierDecPackedOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierDecPackedOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierDecPackedOutputs proto.InternalMessageInfo
+
+func (m *PaillierDecPackedOutputs) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// vectors of signed integers, serialized as the base64 of the ciphertexts back to back
+type PaillierVecEncParams struct {
+	Values    []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	PublicKey string   `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	// return proofs of knowledge of the elements, always returned if the node
+	// is configured with requireProofs
+	WithProof            bool     `protobuf:"varint,3,opt,name=withProof,proto3" json:"withProof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierVecEncParams) Reset()         { *m = PaillierVecEncParams{} }
+func (m *PaillierVecEncParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierVecEncParams) ProtoMessage()    {}
+func (*PaillierVecEncParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{31}
+}
+
+func (m *PaillierVecEncParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierVecEncParams.Unmarshal(m, b)
+}
+func (m *PaillierVecEncParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierVecEncParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierVecEncParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierVecEncParams.Merge(m, src)
+}
+func (m *PaillierVecEncParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierVecEncParams.Size(m)
+}
+func (m *PaillierVecEncParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierVecEncParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierVecEncParams proto.InternalMessageInfo
+
+func (m *PaillierVecEncParams) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *PaillierVecEncParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierVecEncParams) GetWithProof() bool {
+	if m != nil {
+		return m.WithProof
+	}
+	return false
+}
+
+type PaillierVecEncOutputs struct {
+	Vector string `protobuf:"bytes,1,opt,name=vector,proto3" json:"vector,omitempty"`
+	// one proof per element if requested
+	Proofs               []string `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierVecEncOutputs) Reset()         { *m = PaillierVecEncOutputs{} }
+func (m *PaillierVecEncOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierVecEncOutputs) ProtoMessage()    {}
+func (*PaillierVecEncOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{32}
+}
+
+func (m *PaillierVecEncOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierVecEncOutputs.Unmarshal(m, b)
+}
+func (m *PaillierVecEncOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierVecEncOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierVecEncOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierVecEncOutputs.Merge(m, src)
+}
+func (m *PaillierVecEncOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierVecEncOutputs.Size(m)
+}
+func (m *PaillierVecEncOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierVecEncOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierVecEncOutputs proto.InternalMessageInfo
+
+func (m *PaillierVecEncOutputs) GetVector() string {
+	if m != nil {
+		return m.Vector
+	}
+	return ""
+}
+
+func (m *PaillierVecEncOutputs) GetProofs() []string {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+type PaillierVecDecParams struct {
+	Vector               string   `protobuf:"bytes,1,opt,name=vector,proto3" json:"vector,omitempty"`
+	PublicKey            string   `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	PrivateKey           string   `protobuf:"bytes,3,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierVecDecParams) Reset()         { *m = PaillierVecDecParams{} }
+func (m *PaillierVecDecParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierVecDecParams) ProtoMessage()    {}
+func (*PaillierVecDecParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{33}
+}
+
+func (m *PaillierVecDecParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierVecDecParams.Unmarshal(m, b)
+}
+func (m *PaillierVecDecParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierVecDecParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierVecDecParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierVecDecParams.Merge(m, src)
+}
+func (m *PaillierVecDecParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierVecDecParams.Size(m)
+}
+func (m *PaillierVecDecParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierVecDecParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierVecDecParams proto.InternalMessageInfo
+
+func (m *PaillierVecDecParams) GetVector() string {
+	if m != nil {
+		return m.Vector
+	}
+	return ""
+}
+
+func (m *PaillierVecDecParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierVecDecParams) GetPrivateKey() string {
+	if m != nil {
+		return m.PrivateKey
+	}
+	return ""
+}
+
+type PaillierVecDecOutputs struct {
+	Values               []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierVecDecOutputs) Reset()         { *m = PaillierVecDecOutputs{} }
+func (m *PaillierVecDecOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierVecDecOutputs) ProtoMessage()    {}
+func (*PaillierVecDecOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{34}
+}
+
+func (m *PaillierVecDecOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierVecDecOutputs.Unmarshal(m, b)
+}
+func (m *PaillierVecDecOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierVecDecOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierVecDecOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierVecDecOutputs.Merge(m, src)
+}
+func (m *PaillierVecDecOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierVecDecOutputs.Size(m)
+}
+func (m *PaillierVecDecOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierVecDecOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierVecDecOutputs proto.InternalMessageInfo
+
+func (m *PaillierVecDecOutputs) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type PaillierVecAddParams struct {
+	PublicKey   string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Vector1     string `protobuf:"bytes,2,opt,name=vector1,proto3" json:"vector1,omitempty"`
+	Commitment1 string `protobuf:"bytes,3,opt,name=commitment1,proto3" json:"commitment1,omitempty"`
+	Vector2     string `protobuf:"bytes,4,opt,name=vector2,proto3" json:"vector2,omitempty"`
+	Commitment2 string `protobuf:"bytes,5,opt,name=commitment2,proto3" json:"commitment2,omitempty"`
+	// optional proofs of knowledge of the plaintexts, one per element, required
+	// if the node is configured with requireProofs
+	Proofs1              []string `protobuf:"bytes,6,rep,name=proofs1,proto3" json:"proofs1,omitempty"`
+	Proofs2              []string `protobuf:"bytes,7,rep,name=proofs2,proto3" json:"proofs2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierVecAddParams) Reset()         { *m = PaillierVecAddParams{} }
+func (m *PaillierVecAddParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierVecAddParams) ProtoMessage()    {}
+func (*PaillierVecAddParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{35}
+}
+
+func (m *PaillierVecAddParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierVecAddParams.Unmarshal(m, b)
+}
+func (m *PaillierVecAddParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierVecAddParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierVecAddParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierVecAddParams.Merge(m, src)
+}
+func (m *PaillierVecAddParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierVecAddParams.Size(m)
+}
+func (m *PaillierVecAddParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierVecAddParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierVecAddParams proto.InternalMessageInfo
+
+func (m *PaillierVecAddParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierVecAddParams) GetVector1() string {
+	if m != nil {
+		return m.Vector1
+	}
+	return ""
+}
+
+func (m *PaillierVecAddParams) GetCommitment1() string {
+	if m != nil {
+		return m.Commitment1
+	}
+	return ""
+}
+
+func (m *PaillierVecAddParams) GetVector2() string {
+	if m != nil {
+		return m.Vector2
+	}
+	return ""
+}
+
+func (m *PaillierVecAddParams) GetCommitment2() string {
+	if m != nil {
+		return m.Commitment2
+	}
+	return ""
+}
+
+func (m *PaillierVecAddParams) GetProofs1() []string {
+	if m != nil {
+		return m.Proofs1
+	}
+	return nil
+}
+
+func (m *PaillierVecAddParams) GetProofs2() []string {
+	if m != nil {
+		return m.Proofs2
+	}
+	return nil
+}
+
+type PaillierVecAddOutputs struct {
+	Vector               string   `protobuf:"bytes,1,opt,name=vector,proto3" json:"vector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierVecAddOutputs) Reset()         { *m = PaillierVecAddOutputs{} }
+func (m *PaillierVecAddOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierVecAddOutputs) ProtoMessage()    {}
+func (*PaillierVecAddOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{36}
+}
+
+func (m *PaillierVecAddOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierVecAddOutputs.Unmarshal(m, b)
+}
+func (m *PaillierVecAddOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierVecAddOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierVecAddOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierVecAddOutputs.Merge(m, src)
+}
+func (m *PaillierVecAddOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierVecAddOutputs.Size(m)
+}
+func (m *PaillierVecAddOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierVecAddOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierVecAddOutputs proto.InternalMessageInfo
+
+func (m *PaillierVecAddOutputs) GetVector() string {
+	if m != nil {
+		return m.Vector
+	}
+	return ""
+}
+
+type PaillierVecMulParams struct {
+	PublicKey  string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Vector     string `protobuf:"bytes,2,opt,name=vector,proto3" json:"vector,omitempty"`
+	Commitment string `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Scalar     string `protobuf:"bytes,4,opt,name=scalar,proto3" json:"scalar,omitempty"`
+	// optional proofs of knowledge of the plaintexts, one per element, required
+	// if the node is configured with requireProofs
+	Proofs               []string `protobuf:"bytes,5,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierVecMulParams) Reset()         { *m = PaillierVecMulParams{} }
+func (m *PaillierVecMulParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierVecMulParams) ProtoMessage()    {}
+func (*PaillierVecMulParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{37}
+}
+
+func (m *PaillierVecMulParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierVecMulParams.Unmarshal(m, b)
+}
+func (m *PaillierVecMulParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierVecMulParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierVecMulParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierVecMulParams.Merge(m, src)
+}
+func (m *PaillierVecMulParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierVecMulParams.Size(m)
+}
+func (m *PaillierVecMulParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierVecMulParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierVecMulParams proto.InternalMessageInfo
+
+func (m *PaillierVecMulParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierVecMulParams) GetVector() string {
+	if m != nil {
+		return m.Vector
+	}
+	return ""
+}
+
+func (m *PaillierVecMulParams) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+func (m *PaillierVecMulParams) GetScalar() string {
+	if m != nil {
+		return m.Scalar
+	}
+	return ""
+}
+
+func (m *PaillierVecMulParams) GetProofs() []string {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+type PaillierVecMulOutputs struct {
+	Vector               string   `protobuf:"bytes,1,opt,name=vector,proto3" json:"vector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierVecMulOutputs) Reset()         { *m = PaillierVecMulOutputs{} }
+func (m *PaillierVecMulOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierVecMulOutputs) ProtoMessage()    {}
+func (*PaillierVecMulOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{38}
+}
+
+func (m *PaillierVecMulOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierVecMulOutputs.Unmarshal(m, b)
+}
+func (m *PaillierVecMulOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierVecMulOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierVecMulOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierVecMulOutputs.Merge(m, src)
+}
+func (m *PaillierVecMulOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierVecMulOutputs.Size(m)
+}
+func (m *PaillierVecMulOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierVecMulOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierVecMulOutputs proto.InternalMessageInfo
+
+func (m *PaillierVecMulOutputs) GetVector() string {
+	if m != nil {
+		return m.Vector
+	}
+	return ""
+}
+
+type PaillierVecDotParams struct {
+	PublicKey  string   `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Vector     string   `protobuf:"bytes,2,opt,name=vector,proto3" json:"vector,omitempty"`
+	Commitment string   `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Weights    []string `protobuf:"bytes,4,rep,name=weights,proto3" json:"weights,omitempty"`
+	// optional proofs of knowledge of the plaintexts, one per element, required
+	// if the node is configured with requireProofs
+	Proofs               []string `protobuf:"bytes,5,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierVecDotParams) Reset()         { *m = PaillierVecDotParams{} }
+func (m *PaillierVecDotParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierVecDotParams) ProtoMessage()    {}
+func (*PaillierVecDotParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{39}
+}
+
+func (m *PaillierVecDotParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierVecDotParams.Unmarshal(m, b)
+}
+func (m *PaillierVecDotParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierVecDotParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierVecDotParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierVecDotParams.Merge(m, src)
+}
+func (m *PaillierVecDotParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierVecDotParams.Size(m)
+}
+func (m *PaillierVecDotParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierVecDotParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierVecDotParams proto.InternalMessageInfo
+
+func (m *PaillierVecDotParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierVecDotParams) GetVector() string {
+	if m != nil {
+		return m.Vector
+	}
+	return ""
+}
+
+func (m *PaillierVecDotParams) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+func (m *PaillierVecDotParams) GetWeights() []string {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+func (m *PaillierVecDotParams) GetProofs() []string {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+type PaillierVecDotOutputs struct {
+	Ciphertext           string   `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierVecDotOutputs) Reset()         { *m = PaillierVecDotOutputs{} }
+func (m *PaillierVecDotOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierVecDotOutputs) ProtoMessage()    {}
+func (*PaillierVecDotOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{40}
+}
+
+func (m *PaillierVecDotOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierVecDotOutputs.Unmarshal(m, b)
+}
+func (m *PaillierVecDotOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierVecDotOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierVecDotOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierVecDotOutputs.Merge(m, src)
+}
+func (m *PaillierVecDotOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierVecDotOutputs.Size(m)
+}
+func (m *PaillierVecDotOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierVecDotOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierVecDotOutputs proto.InternalMessageInfo
+
+func (m *PaillierVecDotOutputs) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+type PaillierVecSumParams struct {
+	PublicKey  string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Vector     string `protobuf:"bytes,2,opt,name=vector,proto3" json:"vector,omitempty"`
+	Commitment string `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// optional proofs of knowledge of the plaintexts, one per element, required
+	// if the node is configured with requireProofs
+	Proofs               []string `protobuf:"bytes,4,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierVecSumParams) Reset()         { *m = PaillierVecSumParams{} }
+func (m *PaillierVecSumParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierVecSumParams) ProtoMessage()    {}
+func (*PaillierVecSumParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{41}
+}
+
+func (m *PaillierVecSumParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierVecSumParams.Unmarshal(m, b)
+}
+func (m *PaillierVecSumParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierVecSumParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierVecSumParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierVecSumParams.Merge(m, src)
+}
+func (m *PaillierVecSumParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierVecSumParams.Size(m)
+}
+func (m *PaillierVecSumParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierVecSumParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierVecSumParams proto.InternalMessageInfo
+
+func (m *PaillierVecSumParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierVecSumParams) GetVector() string {
+	if m != nil {
+		return m.Vector
+	}
+	return ""
+}
+
+func (m *PaillierVecSumParams) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+func (m *PaillierVecSumParams) GetProofs() []string {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+type PaillierVecSumOutputs struct {
+	Ciphertext           string   `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierVecSumOutputs) Reset()         { *m = PaillierVecSumOutputs{} }
+func (m *PaillierVecSumOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierVecSumOutputs) ProtoMessage()    {}
+func (*PaillierVecSumOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{42}
+}
+
+func (m *PaillierVecSumOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierVecSumOutputs.Unmarshal(m, b)
+}
+func (m *PaillierVecSumOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierVecSumOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierVecSumOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierVecSumOutputs.Merge(m, src)
+}
+func (m *PaillierVecSumOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierVecSumOutputs.Size(m)
+}
+func (m *PaillierVecSumOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierVecSumOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierVecSumOutputs proto.InternalMessageInfo
+
+func (m *PaillierVecSumOutputs) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+type PaillierSumParams struct {
+	PublicKey   string   `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Ciphertexts []string `protobuf:"bytes,2,rep,name=ciphertexts,proto3" json:"ciphertexts,omitempty"`
+	// one commitment per ciphertext
+	Commitments []string `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// optional proofs of knowledge of the plaintexts, one per ciphertext, required
+	// if the node is configured with requireProofs
+	Proofs               []string `protobuf:"bytes,4,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierSumParams) Reset()         { *m = PaillierSumParams{} }
+func (m *PaillierSumParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierSumParams) ProtoMessage()    {}
+func (*PaillierSumParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{43}
+}
+
+func (m *PaillierSumParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierSumParams.Unmarshal(m, b)
+}
+func (m *PaillierSumParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierSumParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierSumParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierSumParams.Merge(m, src)
+}
+func (m *PaillierSumParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierSumParams.Size(m)
+}
+func (m *PaillierSumParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierSumParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierSumParams proto.InternalMessageInfo
+
+func (m *PaillierSumParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierSumParams) GetCiphertexts() []string {
+	if m != nil {
+		return m.Ciphertexts
+	}
+	return nil
+}
+
+func (m *PaillierSumParams) GetCommitments() []string {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *PaillierSumParams) GetProofs() []string {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+type PaillierSumOutputs struct {
+	Ciphertext           string   `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierSumOutputs) Reset()         { *m = PaillierSumOutputs{} }
+func (m *PaillierSumOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierSumOutputs) ProtoMessage()    {}
+func (*PaillierSumOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{44}
+}
+
+func (m *PaillierSumOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierSumOutputs.Unmarshal(m, b)
+}
+func (m *PaillierSumOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierSumOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierSumOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierSumOutputs.Merge(m, src)
+}
+func (m *PaillierSumOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierSumOutputs.Size(m)
+}
+func (m *PaillierSumOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierSumOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierSumOutputs proto.InternalMessageInfo
+
+func (m *PaillierSumOutputs) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+type PaillierLinCombParams struct {
+	PublicKey   string   `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Ciphertexts []string `protobuf:"bytes,2,rep,name=ciphertexts,proto3" json:"ciphertexts,omitempty"`
+	// one commitment per ciphertext
+	Commitments []string `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// signed decimal weights, one per ciphertext
+	Weights []string `protobuf:"bytes,4,rep,name=weights,proto3" json:"weights,omitempty"`
+	// optional proofs of knowledge of the plaintexts, one per ciphertext, required
+	// if the node is configured with requireProofs
+	Proofs               []string `protobuf:"bytes,5,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierLinCombParams) Reset()         { *m = PaillierLinCombParams{} }
+func (m *PaillierLinCombParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierLinCombParams) ProtoMessage()    {}
+func (*PaillierLinCombParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{45}
+}
+
+func (m *PaillierLinCombParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierLinCombParams.Unmarshal(m, b)
+}
+func (m *PaillierLinCombParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierLinCombParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierLinCombParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierLinCombParams.Merge(m, src)
+}
+func (m *PaillierLinCombParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierLinCombParams.Size(m)
+}
+func (m *PaillierLinCombParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierLinCombParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierLinCombParams proto.InternalMessageInfo
+
+func (m *PaillierLinCombParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierLinCombParams) GetCiphertexts() []string {
+	if m != nil {
+		return m.Ciphertexts
+	}
+	return nil
+}
+
+func (m *PaillierLinCombParams) GetCommitments() []string {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *PaillierLinCombParams) GetWeights() []string {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+func (m *PaillierLinCombParams) GetProofs() []string {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+type PaillierLinCombOutputs struct {
+	Ciphertext           string   `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierLinCombOutputs) Reset()         { *m = PaillierLinCombOutputs{} }
+func (m *PaillierLinCombOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierLinCombOutputs) ProtoMessage()    {}
+func (*PaillierLinCombOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{46}
+}
+
+func (m *PaillierLinCombOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierLinCombOutputs.Unmarshal(m, b)
+}
+func (m *PaillierLinCombOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierLinCombOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierLinCombOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierLinCombOutputs.Merge(m, src)
+}
+func (m *PaillierLinCombOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierLinCombOutputs.Size(m)
+}
+func (m *PaillierLinCombOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierLinCombOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierLinCombOutputs proto.InternalMessageInfo
+
+func (m *PaillierLinCombOutputs) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+// no commitment is checked, the key share is the only credential
+type PaillierPartialDecParams struct {
+	// threshold public key and key share of the node, as returned by DealShares
+	ThresholdKey         string   `protobuf:"bytes,1,opt,name=thresholdKey,proto3" json:"thresholdKey,omitempty"`
+	KeyShare             string   `protobuf:"bytes,2,opt,name=keyShare,proto3" json:"keyShare,omitempty"`
+	Ciphertext           string   `protobuf:"bytes,3,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierPartialDecParams) Reset()         { *m = PaillierPartialDecParams{} }
+func (m *PaillierPartialDecParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierPartialDecParams) ProtoMessage()    {}
+func (*PaillierPartialDecParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{47}
+}
+
+func (m *PaillierPartialDecParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierPartialDecParams.Unmarshal(m, b)
+}
+func (m *PaillierPartialDecParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierPartialDecParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierPartialDecParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierPartialDecParams.Merge(m, src)
+}
+func (m *PaillierPartialDecParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierPartialDecParams.Size(m)
+}
+func (m *PaillierPartialDecParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierPartialDecParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierPartialDecParams proto.InternalMessageInfo
+
+func (m *PaillierPartialDecParams) GetThresholdKey() string {
+	if m != nil {
+		return m.ThresholdKey
+	}
+	return ""
+}
+
+func (m *PaillierPartialDecParams) GetKeyShare() string {
+	if m != nil {
+		return m.KeyShare
+	}
+	return ""
+}
+
+func (m *PaillierPartialDecParams) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+type PaillierPartialDecOutputs struct {
+	// partial decryption with its proof of correctness
+	Partial              string   `protobuf:"bytes,1,opt,name=partial,proto3" json:"partial,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierPartialDecOutputs) Reset()         { *m = PaillierPartialDecOutputs{} }
+func (m *PaillierPartialDecOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierPartialDecOutputs) ProtoMessage()    {}
+func (*PaillierPartialDecOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{48}
+}
+
+func (m *PaillierPartialDecOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierPartialDecOutputs.Unmarshal(m, b)
+}
+func (m *PaillierPartialDecOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierPartialDecOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierPartialDecOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierPartialDecOutputs.Merge(m, src)
+}
+func (m *PaillierPartialDecOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierPartialDecOutputs.Size(m)
+}
+func (m *PaillierPartialDecOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierPartialDecOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierPartialDecOutputs proto.InternalMessageInfo
+
+func (m *PaillierPartialDecOutputs) GetPartial() string {
+	if m != nil {
+		return m.Partial
+	}
+	return ""
+}
+
+type PaillierCombineParams struct {
+	ThresholdKey string `protobuf:"bytes,1,opt,name=thresholdKey,proto3" json:"thresholdKey,omitempty"`
+	Ciphertext   string `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	// partial decryptions of at least t nodes
+	Partials []string `protobuf:"bytes,3,rep,name=partials,proto3" json:"partials,omitempty"`
+	// decode the plaintext as a signed integer
+	Signed               bool     `protobuf:"varint,4,opt,name=signed,proto3" json:"signed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierCombineParams) Reset()         { *m = PaillierCombineParams{} }
+func (m *PaillierCombineParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierCombineParams) ProtoMessage()    {}
+func (*PaillierCombineParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{49}
+}
+
+func (m *PaillierCombineParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierCombineParams.Unmarshal(m, b)
+}
+func (m *PaillierCombineParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierCombineParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierCombineParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierCombineParams.Merge(m, src)
+}
+func (m *PaillierCombineParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierCombineParams.Size(m)
+}
+func (m *PaillierCombineParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierCombineParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierCombineParams proto.InternalMessageInfo
+
+func (m *PaillierCombineParams) GetThresholdKey() string {
+	if m != nil {
+		return m.ThresholdKey
+	}
+	return ""
+}
+
+func (m *PaillierCombineParams) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+func (m *PaillierCombineParams) GetPartials() []string {
+	if m != nil {
+		return m.Partials
+	}
+	return nil
+}
+
+func (m *PaillierCombineParams) GetSigned() bool {
+	if m != nil {
+		return m.Signed
+	}
+	return false
+}
+
+type PaillierCombineOutputs struct {
+	Plaintext            string   `protobuf:"bytes,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierCombineOutputs) Reset()         { *m = PaillierCombineOutputs{} }
+func (m *PaillierCombineOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierCombineOutputs) ProtoMessage()    {}
+func (*PaillierCombineOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{50}
+}
+
+func (m *PaillierCombineOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierCombineOutputs.Unmarshal(m, b)
+}
+func (m *PaillierCombineOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierCombineOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierCombineOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierCombineOutputs.Merge(m, src)
+}
+func (m *PaillierCombineOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierCombineOutputs.Size(m)
+}
+func (m *PaillierCombineOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierCombineOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierCombineOutputs proto.InternalMessageInfo
+
+func (m *PaillierCombineOutputs) GetPlaintext() string {
+	if m != nil {
+		return m.Plaintext
+	}
+	return ""
+}
+
+type PaillierVerifyRangeParams struct {
+	PublicKey  string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Ciphertext string `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	// the plaintext must lie in [0, 2^bits), 1 for a 0/1 vote
+	Bits int32 `protobuf:"varint,3,opt,name=bits,proto3" json:"bits,omitempty"`
+	// range proof of the encryptor, see ProveRange
+	Proof                string   `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierVerifyRangeParams) Reset()         { *m = PaillierVerifyRangeParams{} }
+func (m *PaillierVerifyRangeParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierVerifyRangeParams) ProtoMessage()    {}
+func (*PaillierVerifyRangeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{51}
+}
+
+func (m *PaillierVerifyRangeParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierVerifyRangeParams.Unmarshal(m, b)
+}
+func (m *PaillierVerifyRangeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierVerifyRangeParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierVerifyRangeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierVerifyRangeParams.Merge(m, src)
+}
+func (m *PaillierVerifyRangeParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierVerifyRangeParams.Size(m)
+}
+func (m *PaillierVerifyRangeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierVerifyRangeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierVerifyRangeParams proto.InternalMessageInfo
+
+func (m *PaillierVerifyRangeParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierVerifyRangeParams) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+func (m *PaillierVerifyRangeParams) GetBits() int32 {
+	if m != nil {
+		return m.Bits
+	}
+	return 0
+}
+
+func (m *PaillierVerifyRangeParams) GetProof() string {
+	if m != nil {
+		return m.Proof
+	}
+	return ""
+}
+
+// a malformed proof fails the call, a well formed one which does not verify
+// returns valid false. The json tag of the generated field is edited to drop
+// omitempty, so that a false valid is serialized.
+type PaillierVerifyRangeOutputs struct {
+	Valid                bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierVerifyRangeOutputs) Reset()         { *m = PaillierVerifyRangeOutputs{} }
+func (m *PaillierVerifyRangeOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierVerifyRangeOutputs) ProtoMessage()    {}
+func (*PaillierVerifyRangeOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{52}
+}
+
+func (m *PaillierVerifyRangeOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierVerifyRangeOutputs.Unmarshal(m, b)
+}
+func (m *PaillierVerifyRangeOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierVerifyRangeOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierVerifyRangeOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierVerifyRangeOutputs.Merge(m, src)
+}
+func (m *PaillierVerifyRangeOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierVerifyRangeOutputs.Size(m)
+}
+func (m *PaillierVerifyRangeOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierVerifyRangeOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierVerifyRangeOutputs proto.InternalMessageInfo
+
+func (m *PaillierVerifyRangeOutputs) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+// fixed point numbers, serialized as base:exponent:ciphertext in hex
+type PaillierEncDecimalParams struct {
+	// decimal such as "-12.345" or "1e-3"
+	Value     string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	PublicKey string `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	// the value is rounded to a multiple of base^exponent, base 0 selects
+	// DefaultFixedPoint
+	Base                 int32    `protobuf:"varint,3,opt,name=base,proto3" json:"base,omitempty"`
+	Exponent             int32    `protobuf:"varint,4,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierEncDecimalParams) Reset()         { *m = PaillierEncDecimalParams{} }
+func (m *PaillierEncDecimalParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierEncDecimalParams) ProtoMessage()    {}
+func (*PaillierEncDecimalParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{53}
+}
+
+func (m *PaillierEncDecimalParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierEncDecimalParams.Unmarshal(m, b)
+}
+func (m *PaillierEncDecimalParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierEncDecimalParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierEncDecimalParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierEncDecimalParams.Merge(m, src)
+}
+func (m *PaillierEncDecimalParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierEncDecimalParams.Size(m)
+}
+func (m *PaillierEncDecimalParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierEncDecimalParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierEncDecimalParams proto.InternalMessageInfo
+
+func (m *PaillierEncDecimalParams) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *PaillierEncDecimalParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierEncDecimalParams) GetBase() int32 {
+	if m != nil {
+		return m.Base
+	}
+	return 0
+}
+
+func (m *PaillierEncDecimalParams) GetExponent() int32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+type PaillierEncDecimalOutputs struct {
+	Number               string   `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierEncDecimalOutputs) Reset()         { *m = PaillierEncDecimalOutputs{} }
+func (m *PaillierEncDecimalOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierEncDecimalOutputs) ProtoMessage()    {}
+func (*PaillierEncDecimalOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{54}
+}
+
+func (m *PaillierEncDecimalOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierEncDecimalOutputs.Unmarshal(m, b)
+}
+func (m *PaillierEncDecimalOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierEncDecimalOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierEncDecimalOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierEncDecimalOutputs.Merge(m, src)
+}
+func (m *PaillierEncDecimalOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierEncDecimalOutputs.Size(m)
+}
+func (m *PaillierEncDecimalOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierEncDecimalOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierEncDecimalOutputs proto.InternalMessageInfo
+
+func (m *PaillierEncDecimalOutputs) GetNumber() string {
+	if m != nil {
+		return m.Number
+	}
+	return ""
+}
+
+type PaillierDecDecimalParams struct {
+	Number               string   `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	PublicKey            string   `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	PrivateKey           string   `protobuf:"bytes,3,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierDecDecimalParams) Reset()         { *m = PaillierDecDecimalParams{} }
+func (m *PaillierDecDecimalParams) String() string { return proto.CompactTextString(m) }
+func (*PaillierDecDecimalParams) ProtoMessage()    {}
+func (*PaillierDecDecimalParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{55}
+}
+
+func (m *PaillierDecDecimalParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierDecDecimalParams.Unmarshal(m, b)
+}
+func (m *PaillierDecDecimalParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierDecDecimalParams.Marshal(b, m, deterministic)
+}
+func (m *PaillierDecDecimalParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierDecDecimalParams.Merge(m, src)
+}
+func (m *PaillierDecDecimalParams) XXX_Size() int {
+	return xxx_messageInfo_PaillierDecDecimalParams.Size(m)
+}
+func (m *PaillierDecDecimalParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierDecDecimalParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierDecDecimalParams proto.InternalMessageInfo
+
+func (m *PaillierDecDecimalParams) GetNumber() string {
+	if m != nil {
+		return m.Number
+	}
+	return ""
+}
+
+func (m *PaillierDecDecimalParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PaillierDecDecimalParams) GetPrivateKey() string {
+	if m != nil {
+		return m.PrivateKey
+	}
+	return ""
+}
+
+type PaillierDecDecimalOutputs struct {
+	// shortest decimal which encodes to the decrypted mantissa
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaillierDecDecimalOutputs) Reset()         { *m = PaillierDecDecimalOutputs{} }
+func (m *PaillierDecDecimalOutputs) String() string { return proto.CompactTextString(m) }
+func (*PaillierDecDecimalOutputs) ProtoMessage()    {}
+func (*PaillierDecDecimalOutputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375fc9137751f710, []int{56}
+}
+
+func (m *PaillierDecDecimalOutputs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaillierDecDecimalOutputs.Unmarshal(m, b)
+}
+func (m *PaillierDecDecimalOutputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaillierDecDecimalOutputs.Marshal(b, m, deterministic)
+}
+func (m *PaillierDecDecimalOutputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaillierDecDecimalOutputs.Merge(m, src)
+}
+func (m *PaillierDecDecimalOutputs) XXX_Size() int {
+	return xxx_messageInfo_PaillierDecDecimalOutputs.Size(m)
+}
+func (m *PaillierDecDecimalOutputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaillierDecDecimalOutputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaillierDecDecimalOutputs proto.InternalMessageInfo
+
+func (m *PaillierDecDecimalOutputs) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SyscallHeader)(nil), "SyscallHeader")
 	proto.RegisterType((*TrustFunctionCallRequest)(nil), "TrustFunctionCallRequest")
@@ -801,6 +3203,48 @@ func init() {
 	proto.RegisterType((*PaillierMulOutputs)(nil), "PaillierMulOutputs")
 	proto.RegisterType((*PaillierExpParams)(nil), "PaillierExpParams")
 	proto.RegisterType((*PaillierExpOutputs)(nil), "PaillierExpOutputs")
+	proto.RegisterType((*PaillierSubParams)(nil), "PaillierSubParams")
+	proto.RegisterType((*PaillierSubOutputs)(nil), "PaillierSubOutputs")
+	proto.RegisterType((*PaillierNegParams)(nil), "PaillierNegParams")
+	proto.RegisterType((*PaillierNegOutputs)(nil), "PaillierNegOutputs")
+	proto.RegisterType((*PaillierAddPlainParams)(nil), "PaillierAddPlainParams")
+	proto.RegisterType((*PaillierAddPlainOutputs)(nil), "PaillierAddPlainOutputs")
+	proto.RegisterType((*PaillierRerandomizeParams)(nil), "PaillierRerandomizeParams")
+	proto.RegisterType((*PaillierRerandomizeOutputs)(nil), "PaillierRerandomizeOutputs")
+	proto.RegisterType((*PaillierEncBatchParams)(nil), "PaillierEncBatchParams")
+	proto.RegisterType((*PaillierEncBatchOutputs)(nil), "PaillierEncBatchOutputs")
+	proto.RegisterType((*PaillierDecBatchParams)(nil), "PaillierDecBatchParams")
+	proto.RegisterType((*PaillierDecBatchOutputs)(nil), "PaillierDecBatchOutputs")
+	proto.RegisterType((*PaillierEncPackedParams)(nil), "PaillierEncPackedParams")
+	proto.RegisterType((*PaillierEncPackedOutputs)(nil), "PaillierEncPackedOutputs")
+	proto.RegisterType((*PaillierDecPackedParams)(nil), "PaillierDecPackedParams")
+	proto.RegisterType((*PaillierDecPackedOutputs)(nil), "PaillierDecPackedOutputs")
+	proto.RegisterType((*PaillierVecEncParams)(nil), "PaillierVecEncParams")
+	proto.RegisterType((*PaillierVecEncOutputs)(nil), "PaillierVecEncOutputs")
+	proto.RegisterType((*PaillierVecDecParams)(nil), "PaillierVecDecParams")
+	proto.RegisterType((*PaillierVecDecOutputs)(nil), "PaillierVecDecOutputs")
+	proto.RegisterType((*PaillierVecAddParams)(nil), "PaillierVecAddParams")
+	proto.RegisterType((*PaillierVecAddOutputs)(nil), "PaillierVecAddOutputs")
+	proto.RegisterType((*PaillierVecMulParams)(nil), "PaillierVecMulParams")
+	proto.RegisterType((*PaillierVecMulOutputs)(nil), "PaillierVecMulOutputs")
+	proto.RegisterType((*PaillierVecDotParams)(nil), "PaillierVecDotParams")
+	proto.RegisterType((*PaillierVecDotOutputs)(nil), "PaillierVecDotOutputs")
+	proto.RegisterType((*PaillierVecSumParams)(nil), "PaillierVecSumParams")
+	proto.RegisterType((*PaillierVecSumOutputs)(nil), "PaillierVecSumOutputs")
+	proto.RegisterType((*PaillierSumParams)(nil), "PaillierSumParams")
+	proto.RegisterType((*PaillierSumOutputs)(nil), "PaillierSumOutputs")
+	proto.RegisterType((*PaillierLinCombParams)(nil), "PaillierLinCombParams")
+	proto.RegisterType((*PaillierLinCombOutputs)(nil), "PaillierLinCombOutputs")
+	proto.RegisterType((*PaillierPartialDecParams)(nil), "PaillierPartialDecParams")
+	proto.RegisterType((*PaillierPartialDecOutputs)(nil), "PaillierPartialDecOutputs")
+	proto.RegisterType((*PaillierCombineParams)(nil), "PaillierCombineParams")
+	proto.RegisterType((*PaillierCombineOutputs)(nil), "PaillierCombineOutputs")
+	proto.RegisterType((*PaillierVerifyRangeParams)(nil), "PaillierVerifyRangeParams")
+	proto.RegisterType((*PaillierVerifyRangeOutputs)(nil), "PaillierVerifyRangeOutputs")
+	proto.RegisterType((*PaillierEncDecimalParams)(nil), "PaillierEncDecimalParams")
+	proto.RegisterType((*PaillierEncDecimalOutputs)(nil), "PaillierEncDecimalOutputs")
+	proto.RegisterType((*PaillierDecDecimalParams)(nil), "PaillierDecDecimalParams")
+	proto.RegisterType((*PaillierDecDecimalOutputs)(nil), "PaillierDecDecimalOutputs")
 }
 
 func init() {
@@ -808,42 +3252,94 @@ func init() {
 }
 
 var fileDescriptor_375fc9137751f710 = []byte{
-	// 582 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xeb, 0xb8, 0x4d, 0x9a, 0x09, 0x45, 0xb0, 0x42, 0x60, 0xa4, 0xaa, 0x8a, 0x2c, 0x51,
-	0xf5, 0x94, 0xd0, 0x14, 0x89, 0x7b, 0x69, 0x21, 0x52, 0x54, 0x51, 0x19, 0xc4, 0x81, 0x0b, 0xda,
-	0xd8, 0x83, 0xbd, 0xca, 0x7a, 0xed, 0xee, 0x9f, 0x90, 0x3c, 0x02, 0x8f, 0xc4, 0x9d, 0x07, 0x43,
-	0xb6, 0x37, 0xb1, 0x1d, 0x10, 0xed, 0xcd, 0xf3, 0xcd, 0xce, 0xe7, 0xdf, 0xcc, 0x78, 0x0d, 0x87,
-	0xfa, 0xfb, 0x28, 0x97, 0x99, 0xce, 0xfc, 0x57, 0x70, 0xf4, 0x69, 0xad, 0x42, 0xca, 0xf9, 0x14,
-	0x69, 0x84, 0x92, 0x3c, 0x83, 0x83, 0x50, 0xaf, 0x58, 0xe4, 0x39, 0x43, 0xe7, 0xcc, 0x0d, 0xaa,
-	0xc0, 0xff, 0xed, 0x80, 0xf7, 0x59, 0x1a, 0xa5, 0xdf, 0x1b, 0x11, 0x6a, 0x96, 0x89, 0x77, 0x94,
-	0xf3, 0x00, 0xef, 0x0c, 0x2a, 0x4d, 0x4e, 0xa1, 0x9b, 0x94, 0xc5, 0x65, 0xcd, 0x60, 0xf2, 0x78,
-	0xd4, 0xb2, 0x0c, 0x6c, 0x96, 0x3c, 0x87, 0x6e, 0x8a, 0x3a, 0xc9, 0x22, 0xaf, 0x33, 0x74, 0xce,
-	0xfa, 0x81, 0x8d, 0x08, 0x81, 0x7d, 0x2a, 0x63, 0xe5, 0xb9, 0xa5, 0x5a, 0x3e, 0x13, 0x0f, 0x7a,
-	0x34, 0x8a, 0x24, 0x2a, 0xe5, 0xed, 0x97, 0xf2, 0x26, 0x24, 0xc7, 0xd0, 0xcf, 0xcd, 0x9c, 0xb3,
-	0x70, 0x86, 0x6b, 0xef, 0xa0, 0xcc, 0xd5, 0x42, 0x91, 0x55, 0x2c, 0x16, 0x54, 0x1b, 0x89, 0x5e,
-	0xb7, 0xca, 0x6e, 0x05, 0xff, 0x35, 0x74, 0x67, 0x5f, 0x6e, 0x29, 0x93, 0xe4, 0x09, 0xb8, 0x0b,
-	0x5c, 0x97, 0xc0, 0xfd, 0xa0, 0x78, 0x2c, 0x1a, 0x5f, 0x52, 0x6e, 0xd0, 0xc2, 0x55, 0x81, 0xef,
-	0x43, 0xaf, 0xaa, 0x50, 0xe4, 0x05, 0x74, 0x16, 0x4b, 0xcf, 0x19, 0xba, 0x67, 0x83, 0x49, 0x6f,
-	0x54, 0xa9, 0x41, 0x67, 0xb1, 0xf4, 0x23, 0x78, 0xf9, 0x8f, 0xd9, 0xa8, 0x3c, 0x13, 0x0a, 0xc9,
-	0x09, 0xf4, 0x73, 0x4e, 0x99, 0xd0, 0xb8, 0xd2, 0x95, 0xf5, 0x74, 0x2f, 0xa8, 0x25, 0x72, 0x0c,
-	0xee, 0x62, 0x59, 0xf5, 0x3e, 0x98, 0x1c, 0x5a, 0x5b, 0x35, 0xdd, 0x0b, 0x0a, 0xf9, 0xb2, 0x0f,
-	0x3d, 0x89, 0xca, 0x70, 0xad, 0xfc, 0x53, 0x78, 0x34, 0xc3, 0xf5, 0x07, 0x14, 0xb7, 0x54, 0xd2,
-	0x54, 0x15, 0xd3, 0x54, 0x18, 0xce, 0x99, 0xb6, 0x9b, 0xb2, 0x91, 0x7f, 0x03, 0x47, 0xd5, 0xb9,
-	0x8f, 0x46, 0xe7, 0x46, 0x2b, 0x72, 0x02, 0x90, 0x4b, 0xb6, 0xa4, 0x1a, 0x67, 0xdb, 0x8e, 0x1b,
-	0x4a, 0x7b, 0xa0, 0x9d, 0x9d, 0x81, 0xfa, 0x33, 0x78, 0x7a, 0x4b, 0x19, 0xe7, 0x0c, 0xe5, 0xb5,
-	0x08, 0xed, 0xbb, 0x3d, 0xe8, 0xa5, 0xa8, 0x14, 0x8d, 0xd1, 0xfa, 0x6d, 0xc2, 0x7b, 0xcc, 0xde,
-	0x00, 0x69, 0x98, 0x35, 0x00, 0x43, 0x96, 0x27, 0x28, 0xcb, 0x19, 0x59, 0xc0, 0x5a, 0xf1, 0xef,
-	0x6a, 0x84, 0x2b, 0xdc, 0x20, 0xdc, 0x53, 0xf4, 0x7f, 0x90, 0x9d, 0x99, 0xb8, 0xbb, 0x33, 0xf1,
-	0x27, 0x35, 0xe8, 0x15, 0x6e, 0x41, 0x8f, 0x9b, 0xbb, 0x2c, 0x5e, 0xb9, 0xdf, 0xd8, 0xa4, 0xff,
-	0xcb, 0xa9, 0x39, 0x6f, 0x0c, 0xb7, 0x9c, 0x2d, 0x0e, 0x67, 0x97, 0x63, 0x08, 0x83, 0x9a, 0xf9,
-	0xdc, 0x72, 0x36, 0xa5, 0xf6, 0x89, 0x89, 0x45, 0x6d, 0x4a, 0xe5, 0x89, 0x2c, 0x4d, 0x99, 0x4e,
-	0x51, 0xe8, 0x73, 0x7b, 0x5d, 0x9a, 0x52, 0xfb, 0xc4, 0xc4, 0x5e, 0x9a, 0xa6, 0xd4, 0x5c, 0xcc,
-	0x8d, 0xe1, 0x0f, 0x5d, 0xcc, 0xcf, 0x46, 0xc7, 0xd7, 0xab, 0xfc, 0x41, 0x1d, 0xb7, 0x3d, 0x3b,
-	0x7f, 0xed, 0xad, 0xc8, 0x6f, 0xc1, 0x36, 0x9b, 0xa9, 0x95, 0xf2, 0xb3, 0x0f, 0x29, 0xa7, 0xd2,
-	0x36, 0x6a, 0xa3, 0xd6, 0xa7, 0xb5, 0xca, 0x1f, 0xd8, 0xc1, 0xe5, 0xdb, 0xa9, 0xfb, 0xf5, 0x22,
-	0x66, 0x3a, 0x31, 0xf3, 0x51, 0x98, 0xa5, 0xe3, 0x24, 0x13, 0xf1, 0x9a, 0x8a, 0x1f, 0x54, 0xc4,
-	0xe3, 0xdc, 0xda, 0xa9, 0x68, 0x31, 0x5e, 0x85, 0x09, 0x65, 0xe2, 0x5b, 0xce, 0x4d, 0xcc, 0xc4,
-	0x38, 0x9f, 0xcf, 0xbb, 0xe5, 0xef, 0xf3, 0xe2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0xa7,
-	0xc7, 0x52, 0x4a, 0x05, 0x00, 0x00,
+	// 1419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6f, 0x1c, 0xb5,
+	0x1b, 0xee, 0xec, 0x9f, 0xec, 0xee, 0x9b, 0xf6, 0xa7, 0xfe, 0x46, 0x25, 0x9d, 0x44, 0x51, 0x15,
+	0x59, 0xa2, 0xaa, 0x38, 0x24, 0x64, 0x0b, 0x14, 0x24, 0x2e, 0x4d, 0x53, 0x1a, 0x11, 0x5a, 0x56,
+	0xb3, 0xa8, 0x07, 0x2e, 0xc8, 0x3b, 0xe3, 0xec, 0x0e, 0x3b, 0xff, 0x18, 0x7b, 0xd2, 0x6c, 0x0f,
+	0x48, 0x08, 0x24, 0xe0, 0x80, 0xca, 0x07, 0x40, 0x5c, 0x39, 0xf0, 0x01, 0xb8, 0x71, 0x42, 0xe2,
+	0x6b, 0x70, 0xe5, 0x5b, 0x20, 0x7b, 0x3c, 0x33, 0xf6, 0x6c, 0x36, 0x3b, 0xa1, 0x24, 0x1c, 0xb8,
+	0xcd, 0xfb, 0x7a, 0x6c, 0x3f, 0xcf, 0xf3, 0xbe, 0xb6, 0x5f, 0x1b, 0xba, 0xec, 0x68, 0x3b, 0x4e,
+	0x22, 0x16, 0xa1, 0x57, 0xe1, 0xda, 0x70, 0x46, 0x1d, 0xec, 0xfb, 0x07, 0x04, 0xbb, 0x24, 0x31,
+	0x6f, 0x40, 0xdb, 0x61, 0x27, 0x9e, 0x6b, 0x19, 0x5b, 0xc6, 0x9d, 0xa6, 0x9d, 0x19, 0xe8, 0x37,
+	0x03, 0xac, 0x8f, 0x92, 0x94, 0xb2, 0xf7, 0xd2, 0xd0, 0x61, 0x5e, 0x14, 0x3e, 0xc0, 0xbe, 0x6f,
+	0x93, 0xcf, 0x52, 0x42, 0x99, 0x79, 0x1b, 0x56, 0x26, 0xa2, 0xb3, 0xe8, 0xb3, 0xda, 0xff, 0xdf,
+	0xb6, 0x36, 0xa4, 0x2d, 0x5b, 0xcd, 0x35, 0x58, 0x09, 0x08, 0x9b, 0x44, 0xae, 0xd5, 0xd8, 0x32,
+	0xee, 0xf4, 0x6c, 0x69, 0x99, 0x26, 0xb4, 0x70, 0x32, 0xa6, 0x56, 0x53, 0x78, 0xc5, 0xb7, 0x69,
+	0x41, 0x07, 0xbb, 0x6e, 0x42, 0x28, 0xb5, 0x5a, 0xc2, 0x9d, 0x9b, 0xe6, 0x26, 0xf4, 0xe2, 0x74,
+	0xe4, 0x7b, 0xce, 0x21, 0x99, 0x59, 0x6d, 0xd1, 0x56, 0x3a, 0x78, 0x2b, 0xf5, 0xc6, 0x21, 0x66,
+	0x69, 0x42, 0xac, 0x95, 0xac, 0xb5, 0x70, 0xa0, 0xd7, 0x61, 0xe5, 0xf0, 0xe9, 0x00, 0x7b, 0x89,
+	0x79, 0x1d, 0x9a, 0x53, 0x32, 0x13, 0x80, 0x7b, 0x36, 0xff, 0xe4, 0xc4, 0x8f, 0xb1, 0x9f, 0x12,
+	0x09, 0x2e, 0x33, 0x10, 0x82, 0x4e, 0xd6, 0x83, 0x9a, 0x37, 0xa1, 0x31, 0x3d, 0xb6, 0x8c, 0xad,
+	0xe6, 0x9d, 0xd5, 0x7e, 0x67, 0x3b, 0xf3, 0xda, 0x8d, 0xe9, 0x31, 0x72, 0x61, 0xfd, 0x14, 0x6d,
+	0x68, 0x1c, 0x85, 0x94, 0x98, 0xb7, 0xa0, 0x17, 0xfb, 0xd8, 0x0b, 0x19, 0x39, 0x61, 0xd9, 0xd0,
+	0x07, 0x57, 0xec, 0xd2, 0x65, 0x6e, 0x42, 0x73, 0x7a, 0x9c, 0x71, 0x5f, 0xed, 0x77, 0xe5, 0xb0,
+	0xf4, 0xe0, 0x8a, 0xcd, 0xdd, 0x7b, 0x3d, 0xe8, 0x24, 0x84, 0xa6, 0x3e, 0xa3, 0xe8, 0x36, 0x5c,
+	0x3d, 0x24, 0xb3, 0x47, 0x24, 0x1c, 0xe0, 0x04, 0x07, 0x94, 0xab, 0x49, 0x89, 0x33, 0xf2, 0x98,
+	0x8c, 0x94, 0xb4, 0xd0, 0x63, 0xb8, 0x96, 0xfd, 0xf7, 0x61, 0xca, 0xe2, 0x94, 0x51, 0xf3, 0x16,
+	0x40, 0x9c, 0x78, 0xc7, 0x98, 0x91, 0xc3, 0x82, 0xb1, 0xe2, 0xd1, 0x05, 0x6d, 0x54, 0x04, 0x45,
+	0x5f, 0x18, 0xf0, 0xff, 0x01, 0xf6, 0x7c, 0xdf, 0x23, 0xc9, 0xc3, 0xd0, 0x91, 0x93, 0x5b, 0xd0,
+	0x09, 0x08, 0xa5, 0x78, 0x4c, 0xe4, 0x80, 0xb9, 0x79, 0xf6, 0x68, 0x02, 0xb4, 0x37, 0x0e, 0x89,
+	0x2b, 0x08, 0x77, 0x6d, 0x69, 0xf1, 0x5e, 0xcf, 0x3c, 0x36, 0x19, 0x24, 0x51, 0x74, 0x24, 0x02,
+	0xde, 0xb5, 0x4b, 0x07, 0x7a, 0x1f, 0x4c, 0x05, 0x82, 0xc2, 0xcb, 0xf1, 0xe2, 0x09, 0x49, 0x84,
+	0xb4, 0x92, 0x57, 0xe9, 0xe1, 0x01, 0x8d, 0xc5, 0x78, 0x32, 0xa0, 0xc2, 0x40, 0xdf, 0x2a, 0x7c,
+	0xf6, 0x49, 0xce, 0x67, 0xd9, 0x58, 0x67, 0xb3, 0xd2, 0x15, 0x6e, 0xce, 0x29, 0x5c, 0xb2, 0x6e,
+	0xa9, 0xac, 0x11, 0x2b, 0x79, 0xed, 0x93, 0x82, 0xd7, 0xa6, 0x9a, 0x31, 0x1c, 0x4a, 0x4b, 0xcd,
+	0x97, 0x53, 0x59, 0x99, 0xaf, 0xc1, 0xf5, 0xe2, 0x97, 0x7d, 0xe2, 0x78, 0x01, 0xf6, 0x25, 0x8e,
+	0x39, 0x3f, 0xfa, 0x53, 0x51, 0xe0, 0x71, 0xea, 0x4b, 0x05, 0x34, 0x86, 0x46, 0x95, 0xe1, 0x16,
+	0xac, 0x96, 0x6a, 0xec, 0xca, 0xb9, 0x55, 0x97, 0xfe, 0x47, 0x5f, 0x4e, 0xae, 0xba, 0xc4, 0x1f,
+	0x51, 0x10, 0x78, 0x2c, 0x20, 0x21, 0xdb, 0x95, 0xcb, 0x5a, 0x75, 0xe9, 0x7f, 0xf4, 0xe5, 0xe2,
+	0x56, 0x5d, 0x5c, 0x49, 0x41, 0x78, 0x57, 0xae, 0x6d, 0x69, 0x15, 0xfe, 0xbe, 0xd5, 0x51, 0xfc,
+	0x7d, 0xf4, 0x46, 0xa9, 0xf0, 0xe3, 0xd4, 0xaf, 0x99, 0x39, 0xe8, 0x17, 0x35, 0xe7, 0x4f, 0xe2,
+	0x5a, 0x0a, 0xe9, 0x63, 0x36, 0xe6, 0x32, 0x88, 0xb7, 0x17, 0x44, 0xf2, 0x1c, 0x29, 0x3d, 0x22,
+	0x47, 0x1c, 0xec, 0xe3, 0x44, 0x0a, 0x23, 0x2d, 0x25, 0x77, 0xda, 0xda, 0x8a, 0x29, 0xf2, 0x60,
+	0x45, 0xcd, 0x6e, 0x85, 0xef, 0xc3, 0x93, 0xb8, 0x2e, 0x5f, 0x35, 0x23, 0x86, 0xe9, 0xe8, 0xbf,
+	0x92, 0x11, 0xc3, 0x74, 0x54, 0x57, 0xa1, 0xaf, 0x15, 0x85, 0x9e, 0x90, 0xf1, 0xa5, 0x64, 0x44,
+	0x11, 0xe1, 0xd6, 0x82, 0x08, 0x3f, 0x21, 0xe3, 0xba, 0xf8, 0x7f, 0x35, 0x60, 0x2d, 0xef, 0x76,
+	0xdf, 0x75, 0x07, 0x7c, 0x4f, 0xb8, 0x14, 0x12, 0xca, 0x41, 0xd1, 0xd2, 0x0f, 0x8a, 0xf3, 0x25,
+	0xf6, 0x3b, 0x70, 0xb3, 0x8a, 0xbf, 0x2e, 0xf7, 0x17, 0x06, 0xac, 0xe7, 0x7d, 0x6d, 0x92, 0xe0,
+	0xd0, 0x8d, 0x02, 0xef, 0x39, 0xf9, 0x17, 0x63, 0xf8, 0x2e, 0x6c, 0x9c, 0x02, 0xa8, 0x2e, 0x9f,
+	0x6f, 0x94, 0x58, 0x3e, 0x0c, 0x9d, 0x3d, 0xcc, 0x9c, 0x89, 0x24, 0xb3, 0x01, 0x5d, 0x29, 0x2f,
+	0x15, 0x85, 0x4a, 0xcf, 0x2e, 0xec, 0x0b, 0x39, 0x98, 0x87, 0x70, 0xb3, 0x8a, 0x24, 0x67, 0xa1,
+	0xad, 0xfe, 0x1c, 0x8d, 0xea, 0x2a, 0x56, 0x28, 0xb5, 0x1a, 0xa2, 0x51, 0x5a, 0xe8, 0x7b, 0x85,
+	0xdf, 0x3e, 0xd1, 0xf8, 0x2d, 0x1f, 0xf4, 0x62, 0x0e, 0x6a, 0x25, 0xfb, 0xf6, 0x89, 0xce, 0x93,
+	0x0f, 0x99, 0x9f, 0xb0, 0x39, 0x22, 0xc5, 0x83, 0x7e, 0x32, 0x34, 0x8d, 0x06, 0xd8, 0x99, 0x12,
+	0xb7, 0x2c, 0xe1, 0x44, 0x95, 0x99, 0xf7, 0x93, 0xd6, 0x12, 0x12, 0x9b, 0xd0, 0x13, 0xff, 0xed,
+	0x79, 0x2c, 0xab, 0x1b, 0xdb, 0x76, 0xe9, 0xe0, 0x29, 0xc0, 0xcb, 0xed, 0x24, 0x8a, 0x02, 0x41,
+	0xa2, 0x6d, 0x17, 0xb6, 0x1e, 0xcc, 0x76, 0x35, 0x98, 0x47, 0x60, 0xcd, 0x01, 0x3d, 0x47, 0xad,
+	0x45, 0xfd, 0x88, 0x51, 0x81, 0xb6, 0x6d, 0x67, 0x46, 0x99, 0xfd, 0x4d, 0x35, 0xfb, 0x7f, 0x37,
+	0x34, 0x35, 0x35, 0x45, 0x2e, 0xb6, 0x0e, 0xd3, 0x94, 0x6b, 0x9d, 0xa5, 0x5c, 0xbb, 0xa2, 0x1c,
+	0xbf, 0x15, 0x45, 0x69, 0xc8, 0xc4, 0xa6, 0xd4, 0xb6, 0x33, 0x03, 0xf5, 0xc1, 0x9a, 0x23, 0x92,
+	0x2b, 0xb6, 0x20, 0xb6, 0xe8, 0x53, 0xb8, 0x91, 0xf7, 0x79, 0x4a, 0x9c, 0xb2, 0xa2, 0xfe, 0xdb,
+	0xb9, 0x50, 0x46, 0xb4, 0x59, 0x8d, 0xe8, 0x23, 0x78, 0x45, 0x9f, 0x4b, 0x05, 0x47, 0x1c, 0x16,
+	0x25, 0x52, 0x62, 0x69, 0x2d, 0x5c, 0x92, 0xbe, 0x06, 0xba, 0x2c, 0x9b, 0x17, 0x8d, 0xf3, 0x52,
+	0x61, 0x42, 0x3b, 0x1a, 0x6c, 0xa5, 0x32, 0x5e, 0xa4, 0xe9, 0x1f, 0x86, 0x86, 0x8f, 0x1f, 0x10,
+	0x75, 0x36, 0x77, 0x0b, 0x3a, 0x19, 0xde, 0xbc, 0x7c, 0xc9, 0xcd, 0x6a, 0x61, 0xd2, 0x9c, 0x2f,
+	0x4c, 0x8a, 0xbe, 0xfd, 0xfc, 0x5c, 0x93, 0x66, 0x8d, 0x92, 0xc5, 0x82, 0x4e, 0xa6, 0x2b, 0xaf,
+	0x59, 0x38, 0x8f, 0xdc, 0x2c, 0x5b, 0x78, 0xd5, 0xa2, 0xb4, 0xf4, 0x2b, 0x9a, 0xdc, 0x77, 0xdd,
+	0x25, 0xa1, 0x44, 0x3f, 0xe8, 0x9a, 0xd4, 0x2d, 0xf4, 0xcb, 0xe1, 0x1a, 0x5a, 0x44, 0x5f, 0xa2,
+	0x7c, 0x95, 0x19, 0xd5, 0xd6, 0x32, 0x4a, 0xe7, 0xa3, 0xd4, 0xe6, 0x8b, 0xf8, 0xfc, 0xa8, 0xf3,
+	0xd9, 0x8f, 0xd8, 0x85, 0xf2, 0xb1, 0xa0, 0xf3, 0x8c, 0x78, 0xe3, 0x89, 0xd8, 0x28, 0x44, 0x24,
+	0xa4, 0xb9, 0x90, 0xd1, 0x3d, 0x3d, 0x6b, 0x23, 0x56, 0xf7, 0x3c, 0xff, 0x4a, 0x67, 0x36, 0x4c,
+	0x83, 0x8b, 0x8e, 0x94, 0xc4, 0xdf, 0x3a, 0x03, 0xff, 0x30, 0x0d, 0xea, 0xe2, 0xff, 0x4e, 0xbb,
+	0x3d, 0x04, 0xe7, 0xbf, 0x3d, 0xe4, 0xbb, 0x8d, 0xea, 0xd2, 0x97, 0x11, 0x3f, 0xe7, 0x9a, 0xfa,
+	0x32, 0xa2, 0x0b, 0x89, 0x68, 0x15, 0x7e, 0x6d, 0x16, 0x3f, 0x1b, 0x25, 0xff, 0x0f, 0xbc, 0xf0,
+	0x41, 0x14, 0x8c, 0x2e, 0x8d, 0xc9, 0xf9, 0x93, 0xed, 0x6d, 0x58, 0xab, 0x80, 0xad, 0xcb, 0xf3,
+	0x79, 0x79, 0x66, 0x0d, 0x70, 0xc2, 0x3c, 0xec, 0x97, 0xdb, 0x39, 0x82, 0xab, 0x6c, 0x92, 0x10,
+	0x3a, 0x89, 0x7c, 0xb7, 0x24, 0xab, 0xf9, 0xf8, 0x29, 0x39, 0x25, 0xb3, 0xe1, 0x04, 0x27, 0xf9,
+	0x4b, 0x59, 0x61, 0x57, 0xe6, 0x6e, 0xce, 0xcd, 0xfd, 0x26, 0xac, 0xcf, 0xcf, 0x9d, 0x03, 0xe7,
+	0x7b, 0x5f, 0xe6, 0xcc, 0x9f, 0x94, 0xa4, 0x89, 0x5e, 0x28, 0xa1, 0xe1, 0x54, 0xbd, 0x90, 0x9c,
+	0x03, 0xf0, 0xb2, 0x12, 0x7e, 0x03, 0xba, 0x72, 0xa2, 0x3c, 0x36, 0x85, 0xbd, 0xb0, 0x1e, 0x7c,
+	0x0b, 0xd6, 0x2a, 0x80, 0x16, 0x3e, 0xde, 0xf4, 0x94, 0xc7, 0x1b, 0xf4, 0xa5, 0x72, 0x15, 0x79,
+	0x4a, 0x12, 0xef, 0x68, 0x66, 0xe3, 0x70, 0xfc, 0xcf, 0x5c, 0x45, 0x4c, 0x68, 0x8d, 0xca, 0x8a,
+	0x50, 0x7c, 0x2f, 0xb8, 0x7e, 0xf4, 0x61, 0xe3, 0x14, 0x10, 0x39, 0x83, 0xec, 0x1d, 0x54, 0x3e,
+	0x00, 0x77, 0xed, 0xcc, 0x40, 0x9f, 0x6b, 0xc5, 0xa1, 0x7c, 0x4a, 0x92, 0xb8, 0x8b, 0x97, 0x53,
+	0x43, 0x79, 0x39, 0x5d, 0x52, 0x03, 0x70, 0xb4, 0x98, 0x92, 0x02, 0x2d, 0xa6, 0x84, 0x47, 0x82,
+	0x9c, 0xc4, 0x51, 0xc8, 0xf7, 0x2d, 0x59, 0xba, 0xe6, 0x36, 0xba, 0x0b, 0xeb, 0xf3, 0xf3, 0x2b,
+	0x67, 0x46, 0x98, 0x06, 0x23, 0x52, 0x9c, 0x19, 0x99, 0x85, 0x62, 0xad, 0x3e, 0xd3, 0x41, 0x2f,
+	0xe8, 0xf3, 0x92, 0xa5, 0xcb, 0x2e, 0xac, 0xcf, 0xcf, 0xa8, 0x2b, 0x5b, 0xd1, 0x69, 0xef, 0xde,
+	0x41, 0xf3, 0xe3, 0xbb, 0x63, 0x8f, 0x4d, 0xd2, 0xd1, 0xb6, 0x13, 0x05, 0x3b, 0x93, 0x28, 0x1c,
+	0xcf, 0x70, 0xf8, 0x0c, 0x87, 0xe3, 0x9d, 0x58, 0x8e, 0x45, 0xdd, 0xe9, 0xce, 0x89, 0x33, 0xc1,
+	0x5e, 0xf8, 0x49, 0xec, 0xa7, 0x63, 0x2f, 0xdc, 0x89, 0x47, 0xa3, 0x15, 0xf1, 0x82, 0x7f, 0xf7,
+	0xaf, 0x01, 0x00, 0xfa, 0xf3, 0x69, 0x7a, 0xcd, 0x17, 0x00, 0x00,
 }